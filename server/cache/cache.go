@@ -11,10 +11,18 @@ var ErrCacheMiss = errors.New("cache miss")
 //go:generate mockery --name=CacheService --output=../mocks --outpkg=mocks
 type CacheService interface {
 	Set(ctx context.Context, key string, value any, ttl time.Duration) error
+	SetIfNotExists(ctx context.Context, key string, value any, ttl time.Duration) (bool, error)
 	Get(ctx context.Context, key string, target any) error
 	Delete(ctx context.Context, key string) error
 	Exists(ctx context.Context, key string) (bool, error)
 	AddToSet(ctx context.Context, key string, value string, ttl time.Duration) error
 	RemoveFromSet(ctx context.Context, key string, value string) error
 	GetSetMembers(ctx context.Context, key string, target any) error
+	ReplaceSortedSet(ctx context.Context, key string, members []string, ttl time.Duration) error
+	PushToSortedSetIfExists(ctx context.Context, key string, member string, maxSize int64) (bool, error)
+	GetSortedSetMembersBefore(ctx context.Context, key string, before string, count int64) ([]string, error)
+	GetSortedSetSize(ctx context.Context, key string) (int64, error)
+	Expire(ctx context.Context, key string, ttl time.Duration) error
+	Publish(ctx context.Context, channel string, message any) error
+	Subscribe(ctx context.Context, channels ...string) (<-chan []byte, error)
 }
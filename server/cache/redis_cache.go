@@ -9,6 +9,17 @@ import (
 	jsoniter "github.com/json-iterator/go"
 )
 
+// pushToSortedSetScript only touches keys that already exist, so a member
+// pushed concurrently with the key expiring never recreates it without a TTL.
+var pushToSortedSetScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return 0
+end
+redis.call("ZADD", KEYS[1], 0, ARGV[1])
+redis.call("ZREMRANGEBYRANK", KEYS[1], 0, -(tonumber(ARGV[2]) + 1))
+return 1
+`)
+
 type redisCache struct {
 	di     *internal.Di
 	client *redis.Client
@@ -35,6 +46,15 @@ func (r *redisCache) Set(ctx context.Context, key string, value any, ttl time.Du
 	return r.client.Set(ctx, key, JSON, ttl).Err()
 }
 
+func (r *redisCache) SetIfNotExists(ctx context.Context, key string, value any, ttl time.Duration) (bool, error) {
+	JSON, err := jsoniter.Marshal(value)
+	if err != nil {
+		return false, err
+	}
+
+	return r.client.SetNX(ctx, key, JSON, ttl).Result()
+}
+
 func (r *redisCache) Get(ctx context.Context, key string, target any) error {
 	result, err := r.client.Get(ctx, key).Result()
 	if err != nil {
@@ -88,3 +108,94 @@ func (r *redisCache) GetSetMembers(ctx context.Context, key string, target any)
 func (r *redisCache) RemoveFromSet(ctx context.Context, key string, value string) error {
 	return r.client.SRem(ctx, key, value).Err()
 }
+
+// Sorted sets are kept with every score at zero, so members are ordered
+// lexicographically. Callers rely on that to page through time ordered IDs.
+func (r *redisCache) ReplaceSortedSet(ctx context.Context, key string, members []string, ttl time.Duration) error {
+	pipe := r.client.TxPipeline()
+	pipe.Del(ctx, key)
+	if len(members) > 0 {
+		values := make([]*redis.Z, len(members))
+		for i, member := range members {
+			values[i] = &redis.Z{Score: 0, Member: member}
+		}
+
+		pipe.ZAdd(ctx, key, values...)
+		pipe.Expire(ctx, key, ttl)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (r *redisCache) PushToSortedSetIfExists(ctx context.Context, key string, member string, maxSize int64) (bool, error) {
+	pushed, err := pushToSortedSetScript.Run(ctx, r.client, []string{key}, member, maxSize).Int()
+	if err != nil {
+		return false, err
+	}
+
+	return pushed == 1, nil
+}
+
+func (r *redisCache) GetSortedSetMembersBefore(ctx context.Context, key string, before string, count int64) ([]string, error) {
+	max := "+"
+	if before != "" {
+		max = "(" + before
+	}
+
+	return r.client.ZRevRangeByLex(ctx, key, &redis.ZRangeBy{
+		Min:   "-",
+		Max:   max,
+		Count: count,
+	}).Result()
+}
+
+func (r *redisCache) GetSortedSetSize(ctx context.Context, key string) (int64, error) {
+	return r.client.ZCard(ctx, key).Result()
+}
+
+func (r *redisCache) Expire(ctx context.Context, key string, ttl time.Duration) error {
+	return r.client.Expire(ctx, key, ttl).Err()
+}
+
+func (r *redisCache) Publish(ctx context.Context, channel string, message any) error {
+	JSON, err := jsoniter.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	return r.client.Publish(ctx, channel, JSON).Err()
+}
+
+func (r *redisCache) Subscribe(ctx context.Context, channels ...string) (<-chan []byte, error) {
+	pubsub := r.client.Subscribe(ctx, channels...)
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return nil, err
+	}
+
+	messages := make(chan []byte)
+	go func() {
+		defer close(messages)
+		defer pubsub.Close()
+
+		channel := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case message, ok := <-channel:
+				if !ok {
+					return
+				}
+
+				select {
+				case messages <- []byte(message.Payload):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return messages, nil
+}
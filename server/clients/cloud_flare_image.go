@@ -6,6 +6,7 @@ import (
 	"io"
 	"mime/multipart"
 	"net/http"
+	"time"
 
 	"github.com/G-Villarinho/book-wise-api/config"
 	"github.com/G-Villarinho/book-wise-api/internal"
@@ -14,9 +15,14 @@ import (
 	jsoniter "github.com/json-iterator/go"
 )
 
+const defaultCloudFlareImageOwner = "book-wise-api"
+
 type CloudFlareImageClient interface {
 	UploadImage(imageBytes []byte, filename string) (*models.UploadImageResponse, error)
 	DeleteImage(cloudFlareID uuid.UUID) error
+	// ListImages only returns the images uploaded by this service, so other
+	// apps or environments sharing the account are never touched.
+	ListImages(page, perPage int) ([]models.StoredImage, error)
 }
 
 type cloudFlareImageClient struct {
@@ -28,11 +34,12 @@ type cloudflareError struct {
 }
 
 type cloudflareResult struct {
-	Variants          []string `json:"variants"`
-	ID                string   `json:"id"`
-	Filename          string   `json:"filename"`
-	Uploaded          string   `json:"uploaded"`
-	RequireSignedURLs bool     `json:"requireSignedURLs"`
+	Variants          []string       `json:"variants"`
+	ID                string         `json:"id"`
+	Filename          string         `json:"filename"`
+	Uploaded          string         `json:"uploaded"`
+	RequireSignedURLs bool           `json:"requireSignedURLs"`
+	Meta              map[string]any `json:"meta"`
 }
 
 type cloudflareResponse struct {
@@ -42,6 +49,14 @@ type cloudflareResponse struct {
 	Errors   []cloudflareError `json:"errors"`
 }
 
+type cloudflareListResponse struct {
+	Success bool `json:"success"`
+	Result  struct {
+		Images []cloudflareResult `json:"images"`
+	} `json:"result"`
+	Errors []cloudflareError `json:"errors"`
+}
+
 func NewCloudFlareImageClient(i *internal.Di) (CloudFlareImageClient, error) {
 	return &cloudFlareImageClient{
 		i: i,
@@ -61,6 +76,15 @@ func (c *cloudFlareImageClient) UploadImage(imageBytes []byte, filename string)
 		return nil, fmt.Errorf("error copying file to buffer: %w", err)
 	}
 
+	metadata, err := jsoniter.MarshalToString(map[string]string{"owner": getCloudFlareImageOwner()})
+	if err != nil {
+		return nil, fmt.Errorf("error encoding metadata: %w", err)
+	}
+
+	if err := writer.WriteField("metadata", metadata); err != nil {
+		return nil, fmt.Errorf("error writing metadata field: %w", err)
+	}
+
 	if err := writer.Close(); err != nil {
 		return nil, fmt.Errorf("error closing writer: %w", err)
 	}
@@ -135,3 +159,67 @@ func (c *cloudFlareImageClient) DeleteImage(cloudFlareID uuid.UUID) error {
 
 	return nil
 }
+
+func (c *cloudFlareImageClient) ListImages(page, perPage int) ([]models.StoredImage, error) {
+	listURL := fmt.Sprintf("%s?page=%d&per_page=%d", config.Env.CloudFlare.CloudFlareImageApiUrl, page, perPage)
+
+	req, err := http.NewRequest("GET", listURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", config.Env.CloudFlare.CloudFlareImageApiKey))
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("listing images with status code: %d", resp.StatusCode)
+	}
+
+	var cloudflareResp cloudflareListResponse
+	if err := jsoniter.NewDecoder(resp.Body).Decode(&cloudflareResp); err != nil {
+		return nil, fmt.Errorf("decoding JSON response: %w", err)
+	}
+
+	if !cloudflareResp.Success {
+		return nil, fmt.Errorf("cloudflare response error: %+v", cloudflareResp.Errors)
+	}
+
+	var images []models.StoredImage
+	owner := getCloudFlareImageOwner()
+	for _, result := range cloudflareResp.Result.Images {
+		if resultOwner, _ := result.Meta["owner"].(string); resultOwner != owner {
+			continue
+		}
+
+		ID, err := uuid.Parse(result.ID)
+		if err != nil {
+			continue
+		}
+
+		uploadedAt, err := time.Parse(time.RFC3339, result.Uploaded)
+		if err != nil {
+			continue
+		}
+
+		images = append(images, models.StoredImage{
+			ID:         ID,
+			UploadedAt: uploadedAt,
+		})
+	}
+
+	return images, nil
+}
+
+func getCloudFlareImageOwner() string {
+	if config.Env.CloudFlare.CloudFlareImageOwner == "" {
+		return defaultCloudFlareImageOwner
+	}
+
+	return config.Env.CloudFlare.CloudFlareImageOwner
+}
@@ -0,0 +1,123 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/utils"
+)
+
+const maxImageDownloadRedirects = 5
+
+var (
+	errImageURLNotAllowed     = errors.New("image url must use http or https")
+	errImageAddressNotAllowed = errors.New("image url points to a private or reserved address")
+)
+
+// carrierGradeNAT is not covered by net.IP.IsPrivate but is just as internal.
+var carrierGradeNAT = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+type ImageDownloaderClient interface {
+	DownloadImage(ctx context.Context, imageURL string) ([]byte, error)
+}
+
+type imageDownloaderClient struct {
+	di         *internal.Di
+	httpClient *http.Client
+}
+
+func NewImageDownloaderClient(di *internal.Di) (ImageDownloaderClient, error) {
+	// The URLs come from users, so every connection, including the ones made
+	// to follow redirects, is checked against the address actually dialed.
+	dialer := &net.Dialer{
+		Timeout: 5 * time.Second,
+		Control: rejectPrivateAddress,
+	}
+
+	return &imageDownloaderClient{
+		di: di,
+		httpClient: &http.Client{
+			Timeout: 15 * time.Second,
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: 5 * time.Second,
+			},
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				if len(via) >= maxImageDownloadRedirects {
+					return fmt.Errorf("stopped after %d redirects", maxImageDownloadRedirects)
+				}
+
+				return validateImageURL(req.URL)
+			},
+		},
+	}, nil
+}
+
+func (i *imageDownloaderClient) DownloadImage(ctx context.Context, imageURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, imageURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	if err := validateImageURL(req.URL); err != nil {
+		return nil, err
+	}
+
+	resp, err := i.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("download image: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("download image with status code: %d", resp.StatusCode)
+	}
+
+	imageBytes, err := io.ReadAll(io.LimitReader(resp.Body, utils.MaxImageSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read image body: %w", err)
+	}
+
+	if len(imageBytes) > utils.MaxImageSize {
+		return nil, fmt.Errorf("image exceeds the maximum allowed size of 5 MB")
+	}
+
+	contentType := http.DetectContentType(imageBytes)
+	if contentType != "image/jpeg" && contentType != "image/png" {
+		return nil, fmt.Errorf("downloaded file type '%s' is not allowed", contentType)
+	}
+
+	return imageBytes, nil
+}
+
+func validateImageURL(imageURL *url.URL) error {
+	if imageURL.Scheme != "http" && imageURL.Scheme != "https" {
+		return errImageURLNotAllowed
+	}
+
+	return nil
+}
+
+func rejectPrivateAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() || carrierGradeNAT.Contains(ip) {
+		return errImageAddressNotAllowed
+	}
+
+	return nil
+}
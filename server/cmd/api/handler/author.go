@@ -12,6 +12,7 @@ import (
 	"github.com/G-Villarinho/book-wise-api/services"
 	"github.com/G-Villarinho/book-wise-api/utils"
 	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
 	"github.com/labstack/echo/v4"
 )
 
@@ -20,6 +21,9 @@ type AuthorHandler interface {
 	CreateAuthor(ctx echo.Context) error
 	GetAuthors(ctx echo.Context) error
 	DeleteAuthor(ctx echo.Context) error
+	MergeAuthor(ctx echo.Context) error
+	GetAuthorProfile(ctx echo.Context) error
+	GetAuthorBooks(ctx echo.Context) error
 }
 
 type authorHandler struct {
@@ -151,3 +155,115 @@ func (a *authorHandler) DeleteAuthor(ctx echo.Context) error {
 
 	return ctx.NoContent(http.StatusNoContent)
 }
+
+func (a *authorHandler) MergeAuthor(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "authors"),
+		slog.String("func", "MergeAuthor"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	var payload models.MergeAuthorPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Error to decode JSON payload", slog.String("error", err.Error()))
+		return responses.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	validationErrors := validation.ValidateStruct(&payload)
+	if validationErrors != nil {
+		if msg, exists := validationErrors["validation_setup"]; exists {
+			log.Warn("Error in validation setup", slog.String("message", msg))
+			return responses.CannotBindPayloadAPIErrorResponse(ctx)
+		}
+		return responses.NewValidationErrorResponse(ctx, validationErrors)
+	}
+
+	if err := a.authorService.MergeAuthors(ctx.Request().Context(), ID, payload); err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrMergeSameAuthor) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_merge", "Não é possível mesclar um autor com ele mesmo.")
+		}
+
+		if errors.Is(err, models.ErrAuthorNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Não foram encontrados os autores informados para a mesclagem.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (a *authorHandler) GetAuthorProfile(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "authors"),
+		slog.String("func", "GetAuthorProfile"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	response, err := a.authorService.GetAuthorProfile(ctx.Request().Context(), ID)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrAuthorNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhum autor foi encontrado.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (a *authorHandler) GetAuthorBooks(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "authors"),
+		slog.String("func", "GetAuthorBooks"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	bookPagination, err := models.NewPublishedBookPagination(
+		ctx.QueryParam("page"),
+		ctx.QueryParam("limit"),
+		ctx.QueryParam("sort"),
+		ctx.QueryParam("q"),
+		ctx.QueryParam("categoryId"),
+	)
+	if err != nil {
+		log.Error(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_pagination", "Parâmetros de buscas inválidos")
+	}
+
+	response, err := a.authorService.GetPaginatedAuthorPublishedBooks(ctx.Request().Context(), ID, bookPagination)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrAuthorNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhum autor foi encontrado.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/G-Villarinho/book-wise-api/internal"
 	"github.com/G-Villarinho/book-wise-api/models"
 	"github.com/G-Villarinho/book-wise-api/services"
+	"github.com/G-Villarinho/book-wise-api/utils"
 	"github.com/google/uuid"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/labstack/echo/v4"
@@ -28,12 +29,19 @@ type BookHandler interface {
 	UnpublishBook(ctx echo.Context) error
 	EvaluateBook(ctx echo.Context) error
 	GetPublishedBooks(ctx echo.Context) error
+	GetPublishedBook(ctx echo.Context) error
 	GetBookEvaluations(ctx echo.Context) error
+	UpdateBookCover(ctx echo.Context) error
+	GetRecommendedBooks(ctx echo.Context) error
+	GetSimilarBooks(ctx echo.Context) error
+	UpdateBookShelf(ctx echo.Context) error
+	RemoveBookShelf(ctx echo.Context) error
 }
 
 type bookHandler struct {
-	di          *internal.Di
-	bookService services.BookService
+	di                    *internal.Di
+	bookService           services.BookService
+	recommendationService services.RecommendationService
 }
 
 func NewBookHandler(di *internal.Di) (BookHandler, error) {
@@ -42,9 +50,15 @@ func NewBookHandler(di *internal.Di) (BookHandler, error) {
 		return nil, err
 	}
 
+	recommendationService, err := internal.Invoke[services.RecommendationService](di)
+	if err != nil {
+		return nil, err
+	}
+
 	return &bookHandler{
-		di:          di,
-		bookService: bookService,
+		di:                    di,
+		bookService:           bookService,
+		recommendationService: recommendationService,
 	}, nil
 }
 
@@ -334,6 +348,36 @@ func (b *bookHandler) GetPublishedBooks(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, response)
 }
 
+func (b *bookHandler) GetPublishedBook(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "books"),
+		slog.String("func", "GetPublishedBook"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	response, err := b.bookService.GetPublishedBookDetails(ctx.Request().Context(), ID)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrBookNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhum livro foi encontrado.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
 func (b *bookHandler) GetBookEvaluations(ctx echo.Context) error {
 	log := slog.With(
 		slog.String("handler", "books"),
@@ -346,7 +390,7 @@ func (b *bookHandler) GetBookEvaluations(ctx echo.Context) error {
 		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
 	}
 
-	pagination, err := models.NewPagination(ctx.QueryParam("page"), ctx.QueryParam("limit"), ctx.QueryParam("sort"))
+	pagination, err := models.NewEvaluationPagination(ctx.QueryParam("page"), ctx.QueryParam("limit"), ctx.QueryParam("sort"))
 	if err != nil {
 		log.Error(err.Error())
 		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_pagination", "Parâmetros de buscas inválidos")
@@ -360,3 +404,179 @@ func (b *bookHandler) GetBookEvaluations(ctx echo.Context) error {
 
 	return ctx.JSON(http.StatusOK, response)
 }
+
+func (b *bookHandler) UpdateBookCover(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "books"),
+		slog.String("func", "UpdateBookCover"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	file, err := ctx.FormFile("cover")
+	if err != nil {
+		log.Error(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_image", "Somente arquivos de imagem com tamanho até 5 MB e nos formatos JPG, JPEG e PNG são permitidos.")
+	}
+
+	if err := utils.ValidateImage(file); err != nil {
+		log.Error(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_image", "Somente arquivos de imagem com tamanho até 5 MB e nos formatos JPG, JPEG e PNG são permitidos.")
+	}
+
+	payload := models.UpdateBookCoverPayload{
+		Image: file,
+	}
+
+	if err := b.bookService.UpdateBookCover(ctx.Request().Context(), ID, payload); err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrBookNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhum livro foi encontrado.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.NoContent(http.StatusAccepted)
+}
+
+func (b *bookHandler) GetRecommendedBooks(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "books"),
+		slog.String("func", "GetRecommendedBooks"),
+	)
+
+	limit, err := models.ParseRecommendedBooksLimit(ctx.QueryParam("limit"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	response, err := b.recommendationService.GetRecommendedBooks(ctx.Request().Context(), limit)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (b *bookHandler) GetSimilarBooks(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "books"),
+		slog.String("func", "GetSimilarBooks"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	limit, err := models.ParseSimilarBooksLimit(ctx.QueryParam("limit"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	response, err := b.recommendationService.GetSimilarBooks(ctx.Request().Context(), ID, limit)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrBookNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhum livro foi encontrado com esses parâmetros de busca.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (b *bookHandler) UpdateBookShelf(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "books"),
+		slog.String("func", "UpdateBookShelf"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	var payload models.UpdateBookShelfPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Error to decode JSON payload", slog.String("error", err.Error()))
+		return responses.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	validationErrors := validation.ValidateStruct(&payload)
+	if validationErrors != nil {
+		if msg, exists := validationErrors["validation_setup"]; exists {
+			log.Warn("Error in validation setup", slog.String("message", msg))
+			return responses.CannotBindPayloadAPIErrorResponse(ctx)
+		}
+		return responses.NewValidationErrorResponse(ctx, validationErrors)
+	}
+
+	response, err := b.bookService.UpdateBookShelf(ctx.Request().Context(), ID, payload)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrBookNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhum livro foi encontrado.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (b *bookHandler) RemoveBookShelf(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "books"),
+		slog.String("func", "RemoveBookShelf"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	if err := b.bookService.RemoveBookShelf(ctx.Request().Context(), ID); err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrBookShelfNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Este livro não está na sua estante.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
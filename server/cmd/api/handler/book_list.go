@@ -0,0 +1,454 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/G-Villarinho/book-wise-api/cmd/api/responses"
+	"github.com/G-Villarinho/book-wise-api/cmd/api/validation"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/services"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/labstack/echo/v4"
+)
+
+type BookListHandler interface {
+	CreateBookList(ctx echo.Context) error
+	GetBookList(ctx echo.Context) error
+	UpdateBookList(ctx echo.Context) error
+	DeleteBookList(ctx echo.Context) error
+	GetPublicBookLists(ctx echo.Context) error
+	GetUserBookLists(ctx echo.Context) error
+	AddBookToList(ctx echo.Context) error
+	UpdateBookListItem(ctx echo.Context) error
+	RemoveBookFromList(ctx echo.Context) error
+	ReorderBookList(ctx echo.Context) error
+}
+
+type bookListHandler struct {
+	di              *internal.Di
+	bookListService services.BookListService
+}
+
+func NewBookListHandler(di *internal.Di) (BookListHandler, error) {
+	bookListService, err := internal.Invoke[services.BookListService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bookListHandler{
+		di:              di,
+		bookListService: bookListService,
+	}, nil
+}
+
+func (b *bookListHandler) CreateBookList(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "book_lists"),
+		slog.String("func", "CreateBookList"),
+	)
+
+	var payload models.CreateBookListPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Error to decode JSON payload", slog.String("error", err.Error()))
+		return responses.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	validationErrors := validation.ValidateStruct(&payload)
+	if validationErrors != nil {
+		if msg, exists := validationErrors["validation_setup"]; exists {
+			log.Warn("Error in validation setup", slog.String("message", msg))
+			return responses.CannotBindPayloadAPIErrorResponse(ctx)
+		}
+		return responses.NewValidationErrorResponse(ctx, validationErrors)
+	}
+
+	response, err := b.bookListService.CreateBookList(ctx.Request().Context(), payload)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusCreated, response)
+}
+
+func (b *bookListHandler) GetBookList(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "book_lists"),
+		slog.String("func", "GetBookList"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	response, err := b.bookListService.GetBookList(ctx.Request().Context(), ID)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrBookListNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhuma lista foi encontrada.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (b *bookListHandler) UpdateBookList(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "book_lists"),
+		slog.String("func", "UpdateBookList"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	var payload models.UpdateBookListPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Error to decode JSON payload", slog.String("error", err.Error()))
+		return responses.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	validationErrors := validation.ValidateStruct(&payload)
+	if validationErrors != nil {
+		if msg, exists := validationErrors["validation_setup"]; exists {
+			log.Warn("Error in validation setup", slog.String("message", msg))
+			return responses.CannotBindPayloadAPIErrorResponse(ctx)
+		}
+		return responses.NewValidationErrorResponse(ctx, validationErrors)
+	}
+
+	response, err := b.bookListService.UpdateBookList(ctx.Request().Context(), ID, payload)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrBookListNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhuma lista foi encontrada.")
+		}
+
+		if errors.Is(err, models.ErrBookListNotOwned) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusForbidden, "forbidden", "Você não tem permissão para alterar esta lista.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (b *bookListHandler) DeleteBookList(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "book_lists"),
+		slog.String("func", "DeleteBookList"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	if err := b.bookListService.DeleteBookList(ctx.Request().Context(), ID); err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrBookListNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhuma lista foi encontrada.")
+		}
+
+		if errors.Is(err, models.ErrBookListNotOwned) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusForbidden, "forbidden", "Você não tem permissão para alterar esta lista.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (b *bookListHandler) GetPublicBookLists(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "book_lists"),
+		slog.String("func", "GetPublicBookLists"),
+	)
+
+	pagination, err := models.NewBookListPagination(ctx.QueryParam("page"), ctx.QueryParam("limit"), ctx.QueryParam("q"), ctx.QueryParam("bookId"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_pagination", "Parâmetros de buscas inválidos")
+	}
+
+	response, err := b.bookListService.GetPaginatedPublicBookLists(ctx.Request().Context(), pagination)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (b *bookListHandler) GetUserBookLists(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "book_lists"),
+		slog.String("func", "GetUserBookLists"),
+	)
+
+	pagination, err := models.NewBookListPagination(ctx.QueryParam("page"), ctx.QueryParam("limit"), ctx.QueryParam("q"), ctx.QueryParam("bookId"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_pagination", "Parâmetros de buscas inválidos")
+	}
+
+	response, err := b.bookListService.GetPaginatedUserBookLists(ctx.Request().Context(), pagination)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (b *bookListHandler) AddBookToList(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "book_lists"),
+		slog.String("func", "AddBookToList"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	var payload models.AddBookListItemPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Error to decode JSON payload", slog.String("error", err.Error()))
+		return responses.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	validationErrors := validation.ValidateStruct(&payload)
+	if validationErrors != nil {
+		if msg, exists := validationErrors["validation_setup"]; exists {
+			log.Warn("Error in validation setup", slog.String("message", msg))
+			return responses.CannotBindPayloadAPIErrorResponse(ctx)
+		}
+		return responses.NewValidationErrorResponse(ctx, validationErrors)
+	}
+
+	if err := b.bookListService.AddBookToList(ctx.Request().Context(), ID, payload); err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrBookListNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhuma lista foi encontrada.")
+		}
+
+		if errors.Is(err, models.ErrBookListNotOwned) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusForbidden, "forbidden", "Você não tem permissão para alterar esta lista.")
+		}
+
+		if errors.Is(err, models.ErrBookNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhum livro foi encontrado.")
+		}
+
+		if errors.Is(err, models.ErrBookAlreadyInList) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, "conflict", "Este livro já está na lista.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.NoContent(http.StatusCreated)
+}
+
+func (b *bookListHandler) UpdateBookListItem(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "book_lists"),
+		slog.String("func", "UpdateBookListItem"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	bookID, err := uuid.Parse(ctx.Param("bookId"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	var payload models.UpdateBookListItemPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Error to decode JSON payload", slog.String("error", err.Error()))
+		return responses.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	validationErrors := validation.ValidateStruct(&payload)
+	if validationErrors != nil {
+		if msg, exists := validationErrors["validation_setup"]; exists {
+			log.Warn("Error in validation setup", slog.String("message", msg))
+			return responses.CannotBindPayloadAPIErrorResponse(ctx)
+		}
+		return responses.NewValidationErrorResponse(ctx, validationErrors)
+	}
+
+	if err := b.bookListService.UpdateBookListItem(ctx.Request().Context(), ID, bookID, payload); err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrBookListNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhuma lista foi encontrada.")
+		}
+
+		if errors.Is(err, models.ErrBookListNotOwned) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusForbidden, "forbidden", "Você não tem permissão para alterar esta lista.")
+		}
+
+		if errors.Is(err, models.ErrBookNotInList) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Este livro não está na lista.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (b *bookListHandler) RemoveBookFromList(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "book_lists"),
+		slog.String("func", "RemoveBookFromList"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	bookID, err := uuid.Parse(ctx.Param("bookId"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	if err := b.bookListService.RemoveBookFromList(ctx.Request().Context(), ID, bookID); err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrBookListNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhuma lista foi encontrada.")
+		}
+
+		if errors.Is(err, models.ErrBookListNotOwned) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusForbidden, "forbidden", "Você não tem permissão para alterar esta lista.")
+		}
+
+		if errors.Is(err, models.ErrBookNotInList) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Este livro não está na lista.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (b *bookListHandler) ReorderBookList(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "book_lists"),
+		slog.String("func", "ReorderBookList"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	var payload models.ReorderBookListPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Error to decode JSON payload", slog.String("error", err.Error()))
+		return responses.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	validationErrors := validation.ValidateStruct(&payload)
+	if validationErrors != nil {
+		if msg, exists := validationErrors["validation_setup"]; exists {
+			log.Warn("Error in validation setup", slog.String("message", msg))
+			return responses.CannotBindPayloadAPIErrorResponse(ctx)
+		}
+		return responses.NewValidationErrorResponse(ctx, validationErrors)
+	}
+
+	if err := b.bookListService.ReorderBookList(ctx.Request().Context(), ID, payload); err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrBookListNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhuma lista foi encontrada.")
+		}
+
+		if errors.Is(err, models.ErrBookListNotOwned) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusForbidden, "forbidden", "Você não tem permissão para alterar esta lista.")
+		}
+
+		if errors.Is(err, models.ErrInvalidBookListOrder) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_order", "A nova ordem deve conter todos os livros da lista uma única vez.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
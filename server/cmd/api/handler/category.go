@@ -6,15 +6,23 @@ import (
 	"net/http"
 
 	"github.com/G-Villarinho/book-wise-api/cmd/api/responses"
+	"github.com/G-Villarinho/book-wise-api/cmd/api/validation"
 	"github.com/G-Villarinho/book-wise-api/internal"
 	"github.com/G-Villarinho/book-wise-api/models"
 	"github.com/G-Villarinho/book-wise-api/services"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
 	"github.com/labstack/echo/v4"
 )
 
 type CategoryHandler interface {
 	GetCategories(ctx echo.Context) error
 	GetTopCategories(ctx echo.Context) error
+	GetCategoryBySlug(ctx echo.Context) error
+	CreateCategory(ctx echo.Context) error
+	UpdateCategory(ctx echo.Context) error
+	MergeCategory(ctx echo.Context) error
+	DeleteCategory(ctx echo.Context) error
 }
 
 type categoryHandler struct {
@@ -60,7 +68,13 @@ func (c *categoryHandler) GetTopCategories(ctx echo.Context) error {
 		slog.String("func", "GetTopCategories"),
 	)
 
-	response, err := c.categoryService.GetTopCategories(ctx.Request().Context())
+	limit, err := models.ParseTopCategoriesLimit(ctx.QueryParam("limit"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	response, err := c.categoryService.GetTopCategories(ctx.Request().Context(), limit)
 	if err != nil {
 		log.Error(err.Error())
 
@@ -73,3 +87,192 @@ func (c *categoryHandler) GetTopCategories(ctx echo.Context) error {
 
 	return ctx.JSON(http.StatusOK, response)
 }
+
+func (c *categoryHandler) GetCategoryBySlug(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "category"),
+		slog.String("func", "GetCategoryBySlug"),
+	)
+
+	response, err := c.categoryService.GetCategoryBySlug(ctx.Request().Context(), ctx.Param("slug"))
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrCategoryNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhuma categoria foi encontrada.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (c *categoryHandler) CreateCategory(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "category"),
+		slog.String("func", "CreateCategory"),
+	)
+
+	var payload models.CreateCategoryPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Error to decode JSON payload", slog.String("error", err.Error()))
+		return responses.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	validationErrors := validation.ValidateStruct(&payload)
+	if validationErrors != nil {
+		if msg, exists := validationErrors["validation_setup"]; exists {
+			log.Warn("Error in validation setup", slog.String("message", msg))
+			return responses.CannotBindPayloadAPIErrorResponse(ctx)
+		}
+		return responses.NewValidationErrorResponse(ctx, validationErrors)
+	}
+
+	response, err := c.categoryService.CreateCategory(ctx.Request().Context(), payload)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrCategoryNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "A categoria pai informada não foi encontrada.")
+		}
+
+		if errors.Is(err, models.ErrCategoryAlreadyExists) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, "conflict", "Já existe uma categoria com esse nome.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusCreated, response)
+}
+
+func (c *categoryHandler) UpdateCategory(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "category"),
+		slog.String("func", "UpdateCategory"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	var payload models.UpdateCategoryPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Error to decode JSON payload", slog.String("error", err.Error()))
+		return responses.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	validationErrors := validation.ValidateStruct(&payload)
+	if validationErrors != nil {
+		if msg, exists := validationErrors["validation_setup"]; exists {
+			log.Warn("Error in validation setup", slog.String("message", msg))
+			return responses.CannotBindPayloadAPIErrorResponse(ctx)
+		}
+		return responses.NewValidationErrorResponse(ctx, validationErrors)
+	}
+
+	response, err := c.categoryService.UpdateCategory(ctx.Request().Context(), ID, payload)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrCategoryNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhuma categoria foi encontrada.")
+		}
+
+		if errors.Is(err, models.ErrCategoryAlreadyExists) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, "conflict", "Já existe uma categoria com esse nome.")
+		}
+
+		if errors.Is(err, models.ErrInvalidCategoryParent) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_parent", "A categoria não pode ser movida para dentro dela mesma ou de uma subcategoria.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (c *categoryHandler) MergeCategory(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "category"),
+		slog.String("func", "MergeCategory"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	var payload models.MergeCategoryPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Error to decode JSON payload", slog.String("error", err.Error()))
+		return responses.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	validationErrors := validation.ValidateStruct(&payload)
+	if validationErrors != nil {
+		if msg, exists := validationErrors["validation_setup"]; exists {
+			log.Warn("Error in validation setup", slog.String("message", msg))
+			return responses.CannotBindPayloadAPIErrorResponse(ctx)
+		}
+		return responses.NewValidationErrorResponse(ctx, validationErrors)
+	}
+
+	if err := c.categoryService.MergeCategories(ctx.Request().Context(), ID, payload); err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrMergeSameCategory) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_merge", "Não é possível mesclar uma categoria com ela mesma.")
+		}
+
+		if errors.Is(err, models.ErrCategoryNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Não foram encontradas as categorias informadas para a mesclagem.")
+		}
+
+		if errors.Is(err, models.ErrCategoryHasChildren) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, "category_has_children", "A categoria possui subcategorias. Mova ou mescle as subcategorias antes de continuar.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (c *categoryHandler) DeleteCategory(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "category"),
+		slog.String("func", "DeleteCategory"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	if err := c.categoryService.DeleteCategoryByID(ctx.Request().Context(), ID); err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrCategoryNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhuma categoria foi encontrada.")
+		}
+
+		if errors.Is(err, models.ErrCategoryInUse) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, "category_in_use", "A categoria ainda está vinculada a livros e não pode ser removida.")
+		}
+
+		if errors.Is(err, models.ErrCategoryHasChildren) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, "category_has_children", "A categoria possui subcategorias. Mova ou mescle as subcategorias antes de continuar.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
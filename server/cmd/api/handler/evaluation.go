@@ -0,0 +1,344 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/G-Villarinho/book-wise-api/cmd/api/responses"
+	"github.com/G-Villarinho/book-wise-api/cmd/api/validation"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/services"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/labstack/echo/v4"
+)
+
+type EvaluationHandler interface {
+	LikeEvaluation(ctx echo.Context) error
+	UnlikeEvaluation(ctx echo.Context) error
+	CreateComment(ctx echo.Context) error
+	GetComments(ctx echo.Context) error
+	UpdateComment(ctx echo.Context) error
+	DeleteComment(ctx echo.Context) error
+	ReportEvaluation(ctx echo.Context) error
+}
+
+type evaluationHandler struct {
+	di                *internal.Di
+	commentService    services.CommentService
+	evaluationService services.EvaluationService
+	moderationService services.ModerationService
+}
+
+func NewEvaluationHandler(di *internal.Di) (EvaluationHandler, error) {
+	commentService, err := internal.Invoke[services.CommentService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	evaluationService, err := internal.Invoke[services.EvaluationService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	moderationService, err := internal.Invoke[services.ModerationService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &evaluationHandler{
+		di:                di,
+		commentService:    commentService,
+		evaluationService: evaluationService,
+		moderationService: moderationService,
+	}, nil
+}
+
+func (e *evaluationHandler) LikeEvaluation(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "evaluations"),
+		slog.String("func", "LikeEvaluation"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	response, err := e.evaluationService.LikeEvaluation(ctx.Request().Context(), ID)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrEvaluationNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhuma avaliação foi encontrada.")
+		}
+
+		if errors.Is(err, models.ErrCannotLikeOwnEvaluation) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, "conflict", "Você não pode curtir a sua própria avaliação.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (e *evaluationHandler) UnlikeEvaluation(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "evaluations"),
+		slog.String("func", "UnlikeEvaluation"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	response, err := e.evaluationService.UnlikeEvaluation(ctx.Request().Context(), ID)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrEvaluationNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhuma avaliação foi encontrada.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (e *evaluationHandler) CreateComment(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "evaluations"),
+		slog.String("func", "CreateComment"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	var payload models.CreateCommentPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Error to decode JSON payload", slog.String("error", err.Error()))
+		return responses.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	validationErrors := validation.ValidateStruct(&payload)
+	if validationErrors != nil {
+		if msg, exists := validationErrors["validation_setup"]; exists {
+			log.Warn("Error in validation setup", slog.String("message", msg))
+			return responses.CannotBindPayloadAPIErrorResponse(ctx)
+		}
+		return responses.NewValidationErrorResponse(ctx, validationErrors)
+	}
+
+	response, err := e.commentService.CreateComment(ctx.Request().Context(), ID, payload)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) || errors.Is(err, models.ErrUserNotFound) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrEvaluationNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhuma avaliação foi encontrada.")
+		}
+
+		if errors.Is(err, models.ErrInvalidCommentParent) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_parent", "Só é possível responder comentários principais desta avaliação.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusCreated, response)
+}
+
+func (e *evaluationHandler) GetComments(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "evaluations"),
+		slog.String("func", "GetComments"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	pagination, err := models.NewCommentPagination(ctx.QueryParam("page"), ctx.QueryParam("limit"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_pagination", "Parâmetros de buscas inválidos")
+	}
+
+	response, err := e.commentService.GetPaginatedComments(ctx.Request().Context(), ID, pagination)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrEvaluationNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhuma avaliação foi encontrada.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (e *evaluationHandler) UpdateComment(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "evaluations"),
+		slog.String("func", "UpdateComment"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	commentID, err := uuid.Parse(ctx.Param("commentId"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	var payload models.UpdateCommentPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Error to decode JSON payload", slog.String("error", err.Error()))
+		return responses.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	validationErrors := validation.ValidateStruct(&payload)
+	if validationErrors != nil {
+		if msg, exists := validationErrors["validation_setup"]; exists {
+			log.Warn("Error in validation setup", slog.String("message", msg))
+			return responses.CannotBindPayloadAPIErrorResponse(ctx)
+		}
+		return responses.NewValidationErrorResponse(ctx, validationErrors)
+	}
+
+	response, err := e.commentService.UpdateComment(ctx.Request().Context(), ID, commentID, payload)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) || errors.Is(err, models.ErrCommentNotOwned) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrCommentNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhum comentário foi encontrado.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (e *evaluationHandler) DeleteComment(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "evaluations"),
+		slog.String("func", "DeleteComment"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	commentID, err := uuid.Parse(ctx.Param("commentId"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	if err := e.commentService.DeleteComment(ctx.Request().Context(), ID, commentID); err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) || errors.Is(err, models.ErrCommentNotOwned) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrCommentNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhum comentário foi encontrado.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (e *evaluationHandler) ReportEvaluation(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "evaluations"),
+		slog.String("func", "ReportEvaluation"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	var payload models.ReportEvaluationPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Error to decode JSON payload", slog.String("error", err.Error()))
+		return responses.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	validationErrors := validation.ValidateStruct(&payload)
+	if validationErrors != nil {
+		if msg, exists := validationErrors["validation_setup"]; exists {
+			log.Warn("Error in validation setup", slog.String("message", msg))
+			return responses.CannotBindPayloadAPIErrorResponse(ctx)
+		}
+		return responses.NewValidationErrorResponse(ctx, validationErrors)
+	}
+
+	if err := e.moderationService.ReportEvaluation(ctx.Request().Context(), ID, payload); err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrEvaluationNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhuma avaliação foi encontrada.")
+		}
+
+		if errors.Is(err, models.ErrCannotReportOwnEvaluation) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, "conflict", "Você não pode denunciar a sua própria avaliação.")
+		}
+
+		if errors.Is(err, models.ErrEvaluationAlreadyReported) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, "conflict", "Você já denunciou esta avaliação.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.NoContent(http.StatusCreated)
+}
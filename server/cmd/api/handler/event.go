@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/G-Villarinho/book-wise-api/cmd/api/responses"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/services"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/labstack/echo/v4"
+)
+
+const eventsHeartbeatInterval = 30 * time.Second
+
+type EventHandler interface {
+	StreamEvents(ctx echo.Context) error
+}
+
+type eventHandler struct {
+	di           *internal.Di
+	eventService services.EventService
+}
+
+func NewEventHandler(di *internal.Di) (EventHandler, error) {
+	eventService, err := internal.Invoke[services.EventService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventHandler{
+		di:           di,
+		eventService: eventService,
+	}, nil
+}
+
+func (e *eventHandler) StreamEvents(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "events"),
+		slog.String("func", "StreamEvents"),
+	)
+
+	messages, err := e.eventService.Subscribe(ctx.Request().Context())
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	response := ctx.Response()
+	response.Header().Set(echo.HeaderContentType, "text/event-stream")
+	response.Header().Set(echo.HeaderCacheControl, "no-cache")
+	response.Header().Set(echo.HeaderConnection, "keep-alive")
+	response.WriteHeader(http.StatusOK)
+	response.Flush()
+
+	heartbeat := time.NewTicker(eventsHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Request().Context().Done():
+			return nil
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(response, ": heartbeat\n\n"); err != nil {
+				return nil
+			}
+			response.Flush()
+		case message, ok := <-messages:
+			if !ok {
+				return nil
+			}
+
+			var event models.Event
+			if err := jsoniter.Unmarshal(message, &event); err != nil {
+				log.Warn("Error to decode event", slog.String("error", err.Error()))
+				continue
+			}
+
+			if _, err := fmt.Fprintf(response, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, message); err != nil {
+				return nil
+			}
+			response.Flush()
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/G-Villarinho/book-wise-api/cmd/api/responses"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/services"
+	"github.com/labstack/echo/v4"
+)
+
+type FeedHandler interface {
+	GetFeed(ctx echo.Context) error
+}
+
+type feedHandler struct {
+	di          *internal.Di
+	feedService services.FeedService
+}
+
+func NewFeedHandler(di *internal.Di) (FeedHandler, error) {
+	feedService, err := internal.Invoke[services.FeedService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feedHandler{
+		di:          di,
+		feedService: feedService,
+	}, nil
+}
+
+func (f *feedHandler) GetFeed(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "feed"),
+		slog.String("func", "GetFeed"),
+	)
+
+	pagination, err := models.NewFeedPagination(
+		ctx.QueryParam("cursor"),
+		ctx.QueryParam("limit"),
+	)
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_pagination", "Parâmetros de buscas inválidos")
+	}
+
+	response, err := f.feedService.GetFeed(ctx.Request().Context(), pagination)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/G-Villarinho/book-wise-api/cmd/api/responses"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/services"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/labstack/echo/v4"
+)
+
+type FollowHandler interface {
+	FollowAuthor(ctx echo.Context) error
+	UnfollowAuthor(ctx echo.Context) error
+	FollowCategory(ctx echo.Context) error
+	UnfollowCategory(ctx echo.Context) error
+	FollowUser(ctx echo.Context) error
+	UnfollowUser(ctx echo.Context) error
+}
+
+type followHandler struct {
+	di            *internal.Di
+	followService services.FollowService
+}
+
+func NewFollowHandler(di *internal.Di) (FollowHandler, error) {
+	followService, err := internal.Invoke[services.FollowService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &followHandler{
+		di:            di,
+		followService: followService,
+	}, nil
+}
+
+func (f *followHandler) FollowAuthor(ctx echo.Context) error {
+	return f.follow(ctx, "FollowAuthor", models.AuthorFollowTarget)
+}
+
+func (f *followHandler) UnfollowAuthor(ctx echo.Context) error {
+	return f.unfollow(ctx, "UnfollowAuthor", models.AuthorFollowTarget)
+}
+
+func (f *followHandler) FollowCategory(ctx echo.Context) error {
+	return f.follow(ctx, "FollowCategory", models.CategoryFollowTarget)
+}
+
+func (f *followHandler) UnfollowCategory(ctx echo.Context) error {
+	return f.unfollow(ctx, "UnfollowCategory", models.CategoryFollowTarget)
+}
+
+func (f *followHandler) FollowUser(ctx echo.Context) error {
+	return f.follow(ctx, "FollowUser", models.UserFollowTarget)
+}
+
+func (f *followHandler) UnfollowUser(ctx echo.Context) error {
+	return f.unfollow(ctx, "UnfollowUser", models.UserFollowTarget)
+}
+
+func (f *followHandler) follow(ctx echo.Context, funcName string, targetType models.FollowTargetType) error {
+	log := slog.With(
+		slog.String("handler", "follows"),
+		slog.String("func", funcName),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	var payload models.FollowPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil && !errors.Is(err, io.EOF) {
+		log.Warn("Error to decode JSON payload", slog.String("error", err.Error()))
+		return responses.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	response, err := f.followService.Follow(ctx.Request().Context(), targetType, ID, payload)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrAuthorNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhum autor foi encontrado.")
+		}
+
+		if errors.Is(err, models.ErrCategoryNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhuma categoria foi encontrada.")
+		}
+
+		if errors.Is(err, models.ErrUserNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhum usuário foi encontrado.")
+		}
+
+		if errors.Is(err, models.ErrCannotFollowYourself) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_target", "Você não pode seguir a si mesmo.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (f *followHandler) unfollow(ctx echo.Context, funcName string, targetType models.FollowTargetType) error {
+	log := slog.With(
+		slog.String("handler", "follows"),
+		slog.String("func", funcName),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	if err := f.followService.Unfollow(ctx.Request().Context(), targetType, ID); err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrFollowNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Você não segue este conteúdo.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
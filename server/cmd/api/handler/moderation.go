@@ -0,0 +1,193 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/G-Villarinho/book-wise-api/cmd/api/responses"
+	"github.com/G-Villarinho/book-wise-api/cmd/api/validation"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/services"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/labstack/echo/v4"
+)
+
+type ModerationHandler interface {
+	GetModerationQueue(ctx echo.Context) error
+	HideEvaluation(ctx echo.Context) error
+	RestoreEvaluation(ctx echo.Context) error
+	DeleteEvaluation(ctx echo.Context) error
+	WarnUser(ctx echo.Context) error
+}
+
+type moderationHandler struct {
+	di                *internal.Di
+	moderationService services.ModerationService
+}
+
+func NewModerationHandler(di *internal.Di) (ModerationHandler, error) {
+	moderationService, err := internal.Invoke[services.ModerationService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &moderationHandler{
+		di:                di,
+		moderationService: moderationService,
+	}, nil
+}
+
+func (m *moderationHandler) GetModerationQueue(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "moderation"),
+		slog.String("func", "GetModerationQueue"),
+	)
+
+	pagination, err := models.NewModerationPagination(ctx.QueryParam("page"), ctx.QueryParam("limit"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_pagination", "Parâmetros de buscas inválidos")
+	}
+
+	response, err := m.moderationService.GetPaginatedModerationQueue(ctx.Request().Context(), pagination)
+	if err != nil {
+		log.Error(err.Error())
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (m *moderationHandler) HideEvaluation(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "moderation"),
+		slog.String("func", "HideEvaluation"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	if err := m.moderationService.HideEvaluation(ctx.Request().Context(), ID); err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrEvaluationNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhuma avaliação foi encontrada.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (m *moderationHandler) RestoreEvaluation(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "moderation"),
+		slog.String("func", "RestoreEvaluation"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	if err := m.moderationService.RestoreEvaluation(ctx.Request().Context(), ID); err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrEvaluationNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhuma avaliação foi encontrada.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (m *moderationHandler) DeleteEvaluation(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "moderation"),
+		slog.String("func", "DeleteEvaluation"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	if err := m.moderationService.DeleteEvaluation(ctx.Request().Context(), ID); err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrEvaluationNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhuma avaliação foi encontrada.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (m *moderationHandler) WarnUser(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "moderation"),
+		slog.String("func", "WarnUser"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	var payload models.WarnUserPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Error to decode JSON payload", slog.String("error", err.Error()))
+		return responses.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	validationErrors := validation.ValidateStruct(&payload)
+	if validationErrors != nil {
+		if msg, exists := validationErrors["validation_setup"]; exists {
+			log.Warn("Error in validation setup", slog.String("message", msg))
+			return responses.CannotBindPayloadAPIErrorResponse(ctx)
+		}
+		return responses.NewValidationErrorResponse(ctx, validationErrors)
+	}
+
+	if err := m.moderationService.WarnUser(ctx.Request().Context(), ID, payload); err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrEvaluationNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhuma avaliação foi encontrada.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/G-Villarinho/book-wise-api/cmd/api/responses"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/services"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type NotificationHandler interface {
+	GetNotifications(ctx echo.Context) error
+	GetUnreadNotificationsCount(ctx echo.Context) error
+	MarkNotificationAsRead(ctx echo.Context) error
+	MarkAllNotificationsAsRead(ctx echo.Context) error
+}
+
+type notificationHandler struct {
+	di                  *internal.Di
+	notificationService services.NotificationService
+}
+
+func NewNotificationHandler(di *internal.Di) (NotificationHandler, error) {
+	notificationService, err := internal.Invoke[services.NotificationService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &notificationHandler{
+		di:                  di,
+		notificationService: notificationService,
+	}, nil
+}
+
+func (n *notificationHandler) GetNotifications(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "notifications"),
+		slog.String("func", "GetNotifications"),
+	)
+
+	pagination, err := models.NewNotificationPagination(
+		ctx.QueryParam("page"),
+		ctx.QueryParam("limit"),
+		ctx.QueryParam("unread"),
+	)
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_pagination", "Parâmetros de buscas inválidos")
+	}
+
+	response, err := n.notificationService.GetPaginatedNotifications(ctx.Request().Context(), pagination)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (n *notificationHandler) GetUnreadNotificationsCount(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "notifications"),
+		slog.String("func", "GetUnreadNotificationsCount"),
+	)
+
+	response, err := n.notificationService.GetUnreadNotificationsCount(ctx.Request().Context())
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (n *notificationHandler) MarkNotificationAsRead(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "notifications"),
+		slog.String("func", "MarkNotificationAsRead"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	if err := n.notificationService.MarkNotificationAsRead(ctx.Request().Context(), ID); err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrNotificationNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhuma notificação foi encontrada.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (n *notificationHandler) MarkAllNotificationsAsRead(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "notifications"),
+		slog.String("func", "MarkAllNotificationsAsRead"),
+	)
+
+	if err := n.notificationService.MarkAllNotificationsAsRead(ctx.Request().Context()); err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/G-Villarinho/book-wise-api/cmd/api/responses"
+	"github.com/G-Villarinho/book-wise-api/cmd/api/validation"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/services"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/labstack/echo/v4"
+)
+
+type ProfileHandler interface {
+	GetProfile(ctx echo.Context) error
+	ClaimHandle(ctx echo.Context) error
+	UpdatePrivacy(ctx echo.Context) error
+}
+
+type profileHandler struct {
+	di             *internal.Di
+	profileService services.ProfileService
+}
+
+func NewProfileHandler(di *internal.Di) (ProfileHandler, error) {
+	profileService, err := internal.Invoke[services.ProfileService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &profileHandler{
+		di:             di,
+		profileService: profileService,
+	}, nil
+}
+
+func (p *profileHandler) GetProfile(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "profiles"),
+		slog.String("func", "GetProfile"),
+	)
+
+	response, err := p.profileService.GetProfile(ctx.Request().Context(), ctx.Param("handle"))
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrProfileNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhum perfil foi encontrado.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (p *profileHandler) ClaimHandle(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "profiles"),
+		slog.String("func", "ClaimHandle"),
+	)
+
+	var payload models.ClaimHandlePayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Error to decode JSON payload", slog.String("error", err.Error()))
+		return responses.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	validationErrors := validation.ValidateStruct(&payload)
+	if validationErrors != nil {
+		if msg, exists := validationErrors["validation_setup"]; exists {
+			log.Warn("Error in validation setup", slog.String("message", msg))
+			return responses.CannotBindPayloadAPIErrorResponse(ctx)
+		}
+		return responses.NewValidationErrorResponse(ctx, validationErrors)
+	}
+
+	response, err := p.profileService.ClaimHandle(ctx.Request().Context(), payload)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrUserNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhum usuário foi encontrado.")
+		}
+
+		if errors.Is(err, models.ErrInvalidHandle) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_handle", "O nome de usuário deve ter de 3 a 30 letras minúsculas, números ou underscores.")
+		}
+
+		if errors.Is(err, models.ErrHandleAlreadyTaken) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusConflict, "conflict", "Este nome de usuário já está em uso.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (p *profileHandler) UpdatePrivacy(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "profiles"),
+		slog.String("func", "UpdatePrivacy"),
+	)
+
+	var payload models.UpdatePrivacyPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Error to decode JSON payload", slog.String("error", err.Error()))
+		return responses.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	response, err := p.profileService.UpdatePrivacy(ctx.Request().Context(), payload)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrUserNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhum usuário foi encontrado.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
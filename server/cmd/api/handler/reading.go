@@ -0,0 +1,296 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/G-Villarinho/book-wise-api/cmd/api/responses"
+	"github.com/G-Villarinho/book-wise-api/cmd/api/validation"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/services"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/labstack/echo/v4"
+)
+
+type ReadingHandler interface {
+	CreateReadingSession(ctx echo.Context) error
+	GetReadingSessions(ctx echo.Context) error
+	DeleteReadingSession(ctx echo.Context) error
+	GetReadingProgress(ctx echo.Context) error
+	GetReadingStats(ctx echo.Context) error
+	SetReadingChallenge(ctx echo.Context) error
+	GetReadingChallenge(ctx echo.Context) error
+}
+
+type readingHandler struct {
+	di                      *internal.Di
+	readingService          services.ReadingService
+	readingChallengeService services.ReadingChallengeService
+}
+
+func NewReadingHandler(di *internal.Di) (ReadingHandler, error) {
+	readingService, err := internal.Invoke[services.ReadingService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	readingChallengeService, err := internal.Invoke[services.ReadingChallengeService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &readingHandler{
+		di:                      di,
+		readingService:          readingService,
+		readingChallengeService: readingChallengeService,
+	}, nil
+}
+
+func (r *readingHandler) CreateReadingSession(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "reading"),
+		slog.String("func", "CreateReadingSession"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	var payload models.CreateReadingSessionPayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Error to decode JSON payload", slog.String("error", err.Error()))
+		return responses.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	validationErrors := validation.ValidateStruct(&payload)
+	if validationErrors != nil {
+		if msg, exists := validationErrors["validation_setup"]; exists {
+			log.Warn("Error in validation setup", slog.String("message", msg))
+			return responses.CannotBindPayloadAPIErrorResponse(ctx)
+		}
+		return responses.NewValidationErrorResponse(ctx, validationErrors)
+	}
+
+	response, err := r.readingService.CreateReadingSession(ctx.Request().Context(), ID, payload)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrBookNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhum livro foi encontrado.")
+		}
+
+		if errors.Is(err, models.ErrInvalidReadingSessionDate) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_date", "A data da sessão de leitura não pode estar no futuro.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusCreated, response)
+}
+
+func (r *readingHandler) GetReadingSessions(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "reading"),
+		slog.String("func", "GetReadingSessions"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	pagination, err := models.NewReadingSessionPagination(ctx.QueryParam("page"), ctx.QueryParam("limit"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_pagination", "Parâmetros de buscas inválidos")
+	}
+
+	response, err := r.readingService.GetPaginatedReadingSessions(ctx.Request().Context(), ID, pagination)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrBookNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhum livro foi encontrado.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (r *readingHandler) DeleteReadingSession(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "reading"),
+		slog.String("func", "DeleteReadingSession"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	sessionID, err := uuid.Parse(ctx.Param("sessionId"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	if err := r.readingService.DeleteReadingSession(ctx.Request().Context(), ID, sessionID); err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrReadingSessionNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhuma sessão de leitura foi encontrada.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (r *readingHandler) GetReadingProgress(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "reading"),
+		slog.String("func", "GetReadingProgress"),
+	)
+
+	ID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	response, err := r.readingService.GetReadingProgress(ctx.Request().Context(), ID)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrBookNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhum livro foi encontrado.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (r *readingHandler) GetReadingStats(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "reading"),
+		slog.String("func", "GetReadingStats"),
+	)
+
+	response, err := r.readingService.GetReadingStats(ctx.Request().Context())
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (r *readingHandler) SetReadingChallenge(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "reading"),
+		slog.String("func", "SetReadingChallenge"),
+	)
+
+	year, err := strconv.Atoi(ctx.Param("year"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	var payload models.SetReadingChallengePayload
+	if err := jsoniter.NewDecoder(ctx.Request().Body).Decode(&payload); err != nil {
+		log.Warn("Error to decode JSON payload", slog.String("error", err.Error()))
+		return responses.CannotBindPayloadAPIErrorResponse(ctx)
+	}
+
+	validationErrors := validation.ValidateStruct(&payload)
+	if validationErrors != nil {
+		if msg, exists := validationErrors["validation_setup"]; exists {
+			log.Warn("Error in validation setup", slog.String("message", msg))
+			return responses.CannotBindPayloadAPIErrorResponse(ctx)
+		}
+		return responses.NewValidationErrorResponse(ctx, validationErrors)
+	}
+
+	response, err := r.readingChallengeService.SetReadingChallenge(ctx.Request().Context(), year, payload)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrInvalidChallengeYear) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_year", "O desafio de leitura só pode ser definido para o ano atual ou o próximo.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (r *readingHandler) GetReadingChallenge(ctx echo.Context) error {
+	log := slog.With(
+		slog.String("handler", "reading"),
+		slog.String("func", "GetReadingChallenge"),
+	)
+
+	year, err := strconv.Atoi(ctx.Param("year"))
+	if err != nil {
+		log.Warn(err.Error())
+		return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusBadRequest, "invalid_params", "Parametros de busca inválidos.")
+	}
+
+	response, err := r.readingChallengeService.GetReadingChallenge(ctx.Request().Context(), year)
+	if err != nil {
+		log.Error(err.Error())
+
+		if errors.Is(err, models.ErrUserNotFoundInContext) {
+			return responses.AccessDeniedAPIErrorResponse(ctx)
+		}
+
+		if errors.Is(err, models.ErrReadingChallengeNotFound) {
+			return responses.NewCustomValidationAPIErrorResponse(ctx, http.StatusNotFound, "not_found", "Nenhum desafio de leitura foi encontrado para este ano.")
+		}
+
+		return responses.InternalServerAPIErrorResponse(ctx)
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
@@ -3,17 +3,31 @@ package handler
 import (
 	"log"
 
+	"github.com/G-Villarinho/book-wise-api/config"
 	"github.com/G-Villarinho/book-wise-api/internal"
 	"github.com/G-Villarinho/book-wise-api/middleware"
 	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/storage"
 	"github.com/labstack/echo/v4"
 )
 
 func SetupRoutes(e *echo.Echo, di *internal.Di) {
+	e.Use(middleware.ResolveLocale())
+
 	setupAuthRoutes(e, di)
 	setupAuthorHandler(e, di)
 	setupBookRoutes(e, di)
+	setupBookListRoutes(e, di)
 	setupCategoryRoutes(e, di)
+	setupEvaluationRoutes(e, di)
+	setupEventRoutes(e, di)
+	setupFeedRoutes(e, di)
+	setupFollowRoutes(e, di)
+	setupImageRoutes(e)
+	setupModerationRoutes(e, di)
+	setupNotificationRoutes(e, di)
+	setupProfileRoutes(e, di)
+	setupReadingRoutes(e, di)
 	setupUserRoutes(e, di)
 }
 
@@ -66,10 +80,16 @@ func setupBookRoutes(e *echo.Echo, di *internal.Di) {
 	group.DELETE("/:id", bookHandler.DeleteBook, middleware.EnsurePermission(models.DeleteBookPermission))
 	group.PATCH("/:id/publish", bookHandler.PublishBook, middleware.EnsurePermission(models.PublishBookPermission))
 	group.PATCH("/:id/unpublish", bookHandler.UnpublishBook, middleware.EnsurePermission(models.UnpublishBookPermission))
+	group.PATCH("/:id/cover", bookHandler.UpdateBookCover, middleware.EnsurePermission(models.UpdateBookPermission))
 
 	group.POST("/:id/evaluations", bookHandler.EvaluateBook)
 	group.GET("/:id/evaluations", bookHandler.GetBookEvaluations)
 	group.GET("/published", bookHandler.GetPublishedBooks)
+	group.GET("/published/:id", bookHandler.GetPublishedBook)
+	group.GET("/recommended", bookHandler.GetRecommendedBooks)
+	group.GET("/:id/similar", bookHandler.GetSimilarBooks)
+	group.PUT("/:id/shelf", bookHandler.UpdateBookShelf)
+	group.DELETE("/:id/shelf", bookHandler.RemoveBookShelf)
 }
 
 func setupCategoryRoutes(e *echo.Echo, di *internal.Di) {
@@ -82,6 +102,146 @@ func setupCategoryRoutes(e *echo.Echo, di *internal.Di) {
 
 	group.GET("", categoryHandler.GetCategories)
 	group.GET("/top", categoryHandler.GetTopCategories)
+	group.GET("/slug/:slug", categoryHandler.GetCategoryBySlug)
+	group.POST("", categoryHandler.CreateCategory, middleware.EnsurePermission(models.CreateCategoryPermission))
+	group.PUT("/:id", categoryHandler.UpdateCategory, middleware.EnsurePermission(models.UpdateCategoryPermission))
+	group.POST("/:id/merge", categoryHandler.MergeCategory, middleware.EnsurePermission(models.MergeCategoryPermission))
+	group.DELETE("/:id", categoryHandler.DeleteCategory, middleware.EnsurePermission(models.DeleteCategoryPermission))
+}
+
+func setupEvaluationRoutes(e *echo.Echo, di *internal.Di) {
+	evaluationHandler, err := internal.Invoke[EvaluationHandler](di)
+	if err != nil {
+		log.Fatal("error to create evaluation handler: ", err)
+	}
+
+	group := e.Group("/v1/evaluations", middleware.EnsureAuthenticated(di))
+
+	group.POST("/:id/like", evaluationHandler.LikeEvaluation)
+	group.DELETE("/:id/like", evaluationHandler.UnlikeEvaluation)
+	group.GET("/:id/comments", evaluationHandler.GetComments)
+	group.POST("/:id/comments", evaluationHandler.CreateComment)
+	group.PUT("/:id/comments/:commentId", evaluationHandler.UpdateComment)
+	group.DELETE("/:id/comments/:commentId", evaluationHandler.DeleteComment)
+	group.POST("/:id/reports", evaluationHandler.ReportEvaluation)
+}
+
+func setupBookListRoutes(e *echo.Echo, di *internal.Di) {
+	bookListHandler, err := internal.Invoke[BookListHandler](di)
+	if err != nil {
+		log.Fatal("error to create book list handler: ", err)
+	}
+
+	group := e.Group("/v1/lists", middleware.EnsureAuthenticated(di))
+
+	group.POST("", bookListHandler.CreateBookList)
+	group.GET("", bookListHandler.GetPublicBookLists)
+	group.GET("/:id", bookListHandler.GetBookList)
+	group.PUT("/:id", bookListHandler.UpdateBookList)
+	group.DELETE("/:id", bookListHandler.DeleteBookList)
+	group.POST("/:id/items", bookListHandler.AddBookToList)
+	group.PATCH("/:id/items/:bookId", bookListHandler.UpdateBookListItem)
+	group.DELETE("/:id/items/:bookId", bookListHandler.RemoveBookFromList)
+	group.PUT("/:id/order", bookListHandler.ReorderBookList)
+
+	e.GET("/v1/users/me/lists", bookListHandler.GetUserBookLists, middleware.EnsureAuthenticated(di))
+}
+
+func setupReadingRoutes(e *echo.Echo, di *internal.Di) {
+	readingHandler, err := internal.Invoke[ReadingHandler](di)
+	if err != nil {
+		log.Fatal("error to create reading handler: ", err)
+	}
+
+	group := e.Group("/v1/books/:id", middleware.EnsureAuthenticated(di))
+
+	group.GET("/progress", readingHandler.GetReadingProgress)
+	group.GET("/reading-sessions", readingHandler.GetReadingSessions)
+	group.POST("/reading-sessions", readingHandler.CreateReadingSession)
+	group.DELETE("/reading-sessions/:sessionId", readingHandler.DeleteReadingSession)
+
+	meGroup := e.Group("/v1/users/me", middleware.EnsureAuthenticated(di))
+
+	meGroup.GET("/stats", readingHandler.GetReadingStats)
+	meGroup.GET("/challenges/:year", readingHandler.GetReadingChallenge)
+	meGroup.PUT("/challenges/:year", readingHandler.SetReadingChallenge)
+}
+
+func setupProfileRoutes(e *echo.Echo, di *internal.Di) {
+	profileHandler, err := internal.Invoke[ProfileHandler](di)
+	if err != nil {
+		log.Fatal("error to create profile handler: ", err)
+	}
+
+	e.GET("/v1/profiles/:handle", profileHandler.GetProfile, middleware.EnsureAuthenticated(di))
+
+	meGroup := e.Group("/v1/users/me", middleware.EnsureAuthenticated(di))
+
+	meGroup.PUT("/handle", profileHandler.ClaimHandle)
+	meGroup.PUT("/privacy", profileHandler.UpdatePrivacy)
+}
+
+func setupModerationRoutes(e *echo.Echo, di *internal.Di) {
+	moderationHandler, err := internal.Invoke[ModerationHandler](di)
+	if err != nil {
+		log.Fatal("error to create moderation handler: ", err)
+	}
+
+	group := e.Group("/v1/moderation/evaluations", middleware.EnsureAuthenticated(di), middleware.EnsurePermission(models.ModerateEvaluationsPermission))
+
+	group.GET("", moderationHandler.GetModerationQueue)
+	group.PATCH("/:id/hide", moderationHandler.HideEvaluation)
+	group.PATCH("/:id/restore", moderationHandler.RestoreEvaluation)
+	group.DELETE("/:id", moderationHandler.DeleteEvaluation)
+	group.POST("/:id/warn", moderationHandler.WarnUser)
+}
+
+func setupEventRoutes(e *echo.Echo, di *internal.Di) {
+	eventHandler, err := internal.Invoke[EventHandler](di)
+	if err != nil {
+		log.Fatal("error to create event handler: ", err)
+	}
+
+	e.GET("/v1/events", eventHandler.StreamEvents, middleware.EnsureAuthenticated(di))
+}
+
+func setupFollowRoutes(e *echo.Echo, di *internal.Di) {
+	followHandler, err := internal.Invoke[FollowHandler](di)
+	if err != nil {
+		log.Fatal("error to create follow handler: ", err)
+	}
+
+	group := e.Group("/v1", middleware.EnsureAuthenticated(di))
+
+	group.POST("/authors/:id/follow", followHandler.FollowAuthor)
+	group.DELETE("/authors/:id/follow", followHandler.UnfollowAuthor)
+	group.POST("/categories/:id/follow", followHandler.FollowCategory)
+	group.DELETE("/categories/:id/follow", followHandler.UnfollowCategory)
+	group.POST("/users/:id/follow", followHandler.FollowUser)
+	group.DELETE("/users/:id/follow", followHandler.UnfollowUser)
+}
+
+func setupFeedRoutes(e *echo.Echo, di *internal.Di) {
+	feedHandler, err := internal.Invoke[FeedHandler](di)
+	if err != nil {
+		log.Fatal("error to create feed handler: ", err)
+	}
+
+	e.GET("/v1/feed", feedHandler.GetFeed, middleware.EnsureAuthenticated(di))
+}
+
+func setupNotificationRoutes(e *echo.Echo, di *internal.Di) {
+	notificationHandler, err := internal.Invoke[NotificationHandler](di)
+	if err != nil {
+		log.Fatal("error to create notification handler: ", err)
+	}
+
+	group := e.Group("/v1/notifications", middleware.EnsureAuthenticated(di))
+
+	group.GET("", notificationHandler.GetNotifications)
+	group.GET("/unread-count", notificationHandler.GetUnreadNotificationsCount)
+	group.PATCH("/read-all", notificationHandler.MarkAllNotificationsAsRead)
+	group.PATCH("/:id/read", notificationHandler.MarkNotificationAsRead)
 }
 
 func setupAuthorHandler(e *echo.Echo, di *internal.Di) {
@@ -96,4 +256,15 @@ func setupAuthorHandler(e *echo.Echo, di *internal.Di) {
 	group.GET("", authorHandler.GetAuthors, middleware.EnsurePermission(models.ListAuthorsPermission))
 	group.POST("", authorHandler.CreateAuthor, middleware.EnsurePermission(models.CreateAuthorPermission))
 	group.DELETE("/:id", authorHandler.DeleteAuthor, middleware.EnsurePermission(models.DeleteAuthorPermission))
+	group.POST("/:id/merge", authorHandler.MergeAuthor, middleware.EnsurePermission(models.MergeAuthorPermission))
+	group.GET("/:id/profile", authorHandler.GetAuthorProfile)
+	group.GET("/:id/books", authorHandler.GetAuthorBooks)
+}
+
+func setupImageRoutes(e *echo.Echo) {
+	if config.Env.ImageStorage.Driver != storage.LocalDriver {
+		return
+	}
+
+	e.Static("/v1/images", config.Env.ImageStorage.LocalPath)
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/G-Villarinho/book-wise-api/repositories"
 	"github.com/G-Villarinho/book-wise-api/services"
 	"github.com/G-Villarinho/book-wise-api/services/email"
+	"github.com/G-Villarinho/book-wise-api/storage"
 	"github.com/G-Villarinho/book-wise-api/templates"
 	"github.com/go-redis/redis/v8"
 	"github.com/labstack/echo/v4"
@@ -82,11 +83,21 @@ func main() {
 	internal.Provide(di, clients.NewMailtrapClient)
 	internal.Provide(di, clients.NewGoogleBookClient)
 	internal.Provide(di, clients.NewCloudFlareImageClient)
+	internal.Provide(di, storage.NewImageStorage)
 
 	internal.Provide(di, handler.NewAuthHandler)
 	internal.Provide(di, handler.NewAuthorHandler)
 	internal.Provide(di, handler.NewBookHandler)
+	internal.Provide(di, handler.NewBookListHandler)
 	internal.Provide(di, handler.NewCategoryHandler)
+	internal.Provide(di, handler.NewEvaluationHandler)
+	internal.Provide(di, handler.NewEventHandler)
+	internal.Provide(di, handler.NewFeedHandler)
+	internal.Provide(di, handler.NewFollowHandler)
+	internal.Provide(di, handler.NewModerationHandler)
+	internal.Provide(di, handler.NewNotificationHandler)
+	internal.Provide(di, handler.NewProfileHandler)
+	internal.Provide(di, handler.NewReadingHandler)
 	internal.Provide(di, handler.NewUserHandler)
 
 	internal.Provide(di, email.NewEmailService)
@@ -96,18 +107,41 @@ func main() {
 	internal.Provide(di, services.NewAuthService)
 	internal.Provide(di, services.NewAuthorService)
 	internal.Provide(di, services.NewBookService)
+	internal.Provide(di, services.NewBookListService)
 	internal.Provide(di, services.NewCategoryService)
+	internal.Provide(di, services.NewCommentService)
+	internal.Provide(di, services.NewContentFilterService)
 	internal.Provide(di, services.NewEvaluationService)
+	internal.Provide(di, services.NewEventService)
+	internal.Provide(di, services.NewFeedService)
+	internal.Provide(di, services.NewFollowService)
 	internal.Provide(di, services.NewImageService)
+	internal.Provide(di, services.NewModerationService)
+	internal.Provide(di, services.NewNotificationService)
+	internal.Provide(di, services.NewProfileService)
 	internal.Provide(di, services.NewQueueService)
+	internal.Provide(di, services.NewReadingChallengeService)
+	internal.Provide(di, services.NewReadingService)
+	internal.Provide(di, services.NewRecommendationService)
 	internal.Provide(di, services.NewSessionService)
 	internal.Provide(di, services.NewTokenService)
 	internal.Provide(di, services.NewUserService)
 
+	internal.Provide(di, repositories.NewActivityRepository)
 	internal.Provide(di, repositories.NewAuthorRepository)
 	internal.Provide(di, repositories.NewBookRepository)
+	internal.Provide(di, repositories.NewBookListRepository)
+	internal.Provide(di, repositories.NewBookShelfRepository)
 	internal.Provide(di, repositories.NewCategoryRepository)
+	internal.Provide(di, repositories.NewCommentRepository)
 	internal.Provide(di, repositories.NewEvaluationRepository)
+	internal.Provide(di, repositories.NewEvaluationReportRepository)
+	internal.Provide(di, repositories.NewFollowRepository)
+	internal.Provide(di, repositories.NewImageRepository)
+	internal.Provide(di, repositories.NewNotificationRepository)
+	internal.Provide(di, repositories.NewReadingChallengeRepository)
+	internal.Provide(di, repositories.NewReadingSessionRepository)
+	internal.Provide(di, repositories.NewRecommendationRepository)
 	internal.Provide(di, repositories.NewUserRepository)
 
 	handler.SetupRoutes(e, di)
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/G-Villarinho/book-wise-api/clients"
+	"github.com/G-Villarinho/book-wise-api/config"
+	"github.com/G-Villarinho/book-wise-api/database"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/repositories"
+	"github.com/G-Villarinho/book-wise-api/services"
+	jsoniter "github.com/json-iterator/go"
+	"gorm.io/gorm"
+)
+
+// Queues an upload for every book whose cover still points at the external
+// URL it was created with, so the upload_book_cover_image worker moves it to
+// our own image storage. Safe to run again: uploaded covers are skipped.
+func main() {
+	config.ConfigureLogger()
+	config.LoadEnvironments()
+
+	di := internal.NewDi()
+
+	ctx := context.Background()
+	db, err := database.NewMysqlConnection(ctx)
+	if err != nil {
+		log.Fatal("error to connect to database: ", err)
+	}
+
+	rabbitMQClient, err := clients.NewRabbitMQClient(di)
+	if err != nil {
+		log.Fatal("error initializing RabbitMQ client: ", err)
+	}
+
+	if err := rabbitMQClient.Connect(); err != nil {
+		log.Fatal("error connecting to RabbitMQ: ", err)
+	}
+	defer func() {
+		if err := rabbitMQClient.Disconnect(); err != nil {
+			log.Println("error disconnecting from RabbitMQ:", err)
+		}
+	}()
+
+	internal.Provide(di, func(d *internal.Di) (clients.RabbitMQClient, error) {
+		return rabbitMQClient, nil
+	})
+
+	internal.Provide(di, func(d *internal.Di) (*gorm.DB, error) {
+		return db, nil
+	})
+
+	internal.Provide(di, services.NewQueueService)
+	internal.Provide(di, repositories.NewBookRepository)
+
+	queueService, err := internal.Invoke[services.QueueService](di)
+	if err != nil {
+		log.Fatal("error to create queue service: ", err)
+	}
+
+	bookRepository, err := internal.Invoke[repositories.BookRepository](di)
+	if err != nil {
+		log.Fatal("error to create book repository: ", err)
+	}
+
+	books, err := bookRepository.GetBooksWithExternalCover(ctx)
+	if err != nil {
+		log.Fatal("error to get books with external cover: ", err)
+	}
+
+	queued := 0
+	for _, book := range books {
+		message, err := jsoniter.Marshal(models.BookCoverTask{BookID: book.ID, SourceURL: book.CoverImageURL})
+		if err != nil {
+			log.Printf("error to marshal book cover task for book %s: %s", book.ID, err.Error())
+			continue
+		}
+
+		if err := queueService.Publish(services.UploadBookCover, message); err != nil {
+			log.Printf("error to publish book cover task for book %s: %s", book.ID, err.Error())
+			continue
+		}
+
+		queued++
+	}
+
+	log.Printf("book cover backfill finished, %d of %d covers queued", queued, len(books))
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/G-Villarinho/book-wise-api/cache"
+	"github.com/G-Villarinho/book-wise-api/clients"
+	"github.com/G-Villarinho/book-wise-api/config"
+	"github.com/G-Villarinho/book-wise-api/database"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/repositories"
+	"github.com/G-Villarinho/book-wise-api/services"
+	"github.com/go-redis/redis/v8"
+	jsoniter "github.com/json-iterator/go"
+	"gorm.io/gorm"
+)
+
+func main() {
+	config.ConfigureLogger()
+	config.LoadEnvironments()
+
+	di := internal.NewDi()
+
+	ctx := context.Background()
+	db, err := database.NewMysqlConnection(ctx)
+	if err != nil {
+		log.Fatal("error to connect to database: ", err)
+	}
+
+	redisClient, err := database.NewRedisConnection(ctx)
+	if err != nil {
+		log.Fatal("error to connect to redis: ", err)
+	}
+
+	rabbitMQClient, err := clients.NewRabbitMQClient(di)
+	if err != nil {
+		log.Fatal("error initializing RabbitMQ client: ", err)
+	}
+
+	if err := rabbitMQClient.Connect(); err != nil {
+		log.Fatal("error connecting to RabbitMQ: ", err)
+	}
+	defer func() {
+		if err := rabbitMQClient.Disconnect(); err != nil {
+			log.Println("error disconnecting from RabbitMQ:", err)
+		}
+	}()
+
+	internal.Provide(di, func(d *internal.Di) (clients.RabbitMQClient, error) {
+		return rabbitMQClient, nil
+	})
+
+	internal.Provide(di, func(d *internal.Di) (*gorm.DB, error) {
+		return db, nil
+	})
+
+	internal.Provide(di, func(d *internal.Di) (*redis.Client, error) {
+		return redisClient, nil
+	})
+
+	internal.Provide(di, cache.NewRedisCache)
+	internal.Provide(di, services.NewQueueService)
+	internal.Provide(di, services.NewEventService)
+	internal.Provide(di, services.NewNotificationService)
+	internal.Provide(di, repositories.NewBookRepository)
+	internal.Provide(di, repositories.NewFollowRepository)
+	internal.Provide(di, repositories.NewNotificationRepository)
+
+	notificationService, err := internal.Invoke[services.NotificationService](di)
+	if err != nil {
+		log.Fatal("error to create notification service: ", err)
+	}
+
+	queueService, err := internal.Invoke[services.QueueService](di)
+	if err != nil {
+		log.Fatal("error to create queue service: ", err)
+	}
+
+	for {
+		messages, err := queueService.Consume(services.CreateNotifications)
+		if err != nil {
+			log.Fatal("error to consume message from queue: ", err)
+		}
+
+		for message := range messages {
+			var task models.NotificationTask
+			if err := jsoniter.Unmarshal(message, &task); err != nil {
+				log.Println("error unmarshalling notification task: ", err)
+				continue
+			}
+
+			created, err := notificationService.CreateNotifications(ctx, task)
+			if err != nil {
+				log.Printf("error to create %s notifications: %s", task.Type, err.Error())
+				continue
+			}
+
+			log.Printf("%d %s notifications created", created, task.Type)
+		}
+	}
+}
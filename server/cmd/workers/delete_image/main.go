@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/G-Villarinho/book-wise-api/clients"
+	"github.com/G-Villarinho/book-wise-api/config"
+	"github.com/G-Villarinho/book-wise-api/database"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/repositories"
+	"github.com/G-Villarinho/book-wise-api/services"
+	"github.com/G-Villarinho/book-wise-api/storage"
+	jsoniter "github.com/json-iterator/go"
+	"gorm.io/gorm"
+)
+
+func main() {
+	config.ConfigureLogger()
+	config.LoadEnvironments()
+
+	di := internal.NewDi()
+
+	ctx := context.Background()
+	db, err := database.NewMysqlConnection(ctx)
+	if err != nil {
+		log.Fatal("error to connect to database: ", err)
+	}
+
+	rabbitMQClient, err := clients.NewRabbitMQClient(di)
+	if err != nil {
+		log.Fatal("error initializing RabbitMQ client: ", err)
+	}
+
+	if err := rabbitMQClient.Connect(); err != nil {
+		log.Fatal("error connecting to RabbitMQ: ", err)
+	}
+	defer func() {
+		if err := rabbitMQClient.Disconnect(); err != nil {
+			log.Println("error disconnecting from RabbitMQ:", err)
+		}
+	}()
+
+	internal.Provide(di, func(d *internal.Di) (clients.RabbitMQClient, error) {
+		return rabbitMQClient, nil
+	})
+
+	internal.Provide(di, func(d *internal.Di) (*gorm.DB, error) {
+		return db, nil
+	})
+
+	internal.Provide(di, clients.NewCloudFlareImageClient)
+	internal.Provide(di, storage.NewImageStorage)
+	internal.Provide(di, services.NewQueueService)
+	internal.Provide(di, services.NewImageService)
+	internal.Provide(di, repositories.NewImageRepository)
+
+	imageService, err := internal.Invoke[services.ImageService](di)
+	if err != nil {
+		log.Fatal("error to create image service: ", err)
+	}
+
+	queueService, err := internal.Invoke[services.QueueService](di)
+	if err != nil {
+		log.Fatal("error to create queue service: ", err)
+	}
+
+	for {
+		messages, err := queueService.Consume(string(services.DeleteImageQueue))
+		if err != nil {
+			log.Fatal("error to consume message from queue: ", err)
+		}
+
+		for message := range messages {
+			var task models.ImageDeleteTask
+			if err := jsoniter.Unmarshal(message, &task); err != nil {
+				log.Println("error unmarshalling image delete task: ", err)
+				continue
+			}
+
+			for _, imageID := range task.ImageIDs {
+				if err := imageService.DeleteImage(ctx, imageID); err != nil {
+					log.Printf("error to delete image %s: %s", imageID, err.Error())
+					continue
+				}
+
+				log.Printf("image %s deleted successfully", imageID)
+			}
+		}
+	}
+}
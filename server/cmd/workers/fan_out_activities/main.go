@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/G-Villarinho/book-wise-api/cache"
+	"github.com/G-Villarinho/book-wise-api/clients"
+	"github.com/G-Villarinho/book-wise-api/config"
+	"github.com/G-Villarinho/book-wise-api/database"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/repositories"
+	"github.com/G-Villarinho/book-wise-api/services"
+	"github.com/go-redis/redis/v8"
+	jsoniter "github.com/json-iterator/go"
+	"gorm.io/gorm"
+)
+
+func main() {
+	config.ConfigureLogger()
+	config.LoadEnvironments()
+
+	di := internal.NewDi()
+
+	ctx := context.Background()
+	db, err := database.NewMysqlConnection(ctx)
+	if err != nil {
+		log.Fatal("error to connect to database: ", err)
+	}
+
+	redisClient, err := database.NewRedisConnection(ctx)
+	if err != nil {
+		log.Fatal("error to connect to redis: ", err)
+	}
+
+	rabbitMQClient, err := clients.NewRabbitMQClient(di)
+	if err != nil {
+		log.Fatal("error initializing RabbitMQ client: ", err)
+	}
+
+	if err := rabbitMQClient.Connect(); err != nil {
+		log.Fatal("error connecting to RabbitMQ: ", err)
+	}
+	defer func() {
+		if err := rabbitMQClient.Disconnect(); err != nil {
+			log.Println("error disconnecting from RabbitMQ:", err)
+		}
+	}()
+
+	internal.Provide(di, func(d *internal.Di) (clients.RabbitMQClient, error) {
+		return rabbitMQClient, nil
+	})
+
+	internal.Provide(di, func(d *internal.Di) (*gorm.DB, error) {
+		return db, nil
+	})
+
+	internal.Provide(di, func(d *internal.Di) (*redis.Client, error) {
+		return redisClient, nil
+	})
+
+	internal.Provide(di, cache.NewRedisCache)
+	internal.Provide(di, services.NewQueueService)
+	internal.Provide(di, services.NewFeedService)
+	internal.Provide(di, repositories.NewActivityRepository)
+	internal.Provide(di, repositories.NewFollowRepository)
+
+	feedService, err := internal.Invoke[services.FeedService](di)
+	if err != nil {
+		log.Fatal("error to create feed service: ", err)
+	}
+
+	queueService, err := internal.Invoke[services.QueueService](di)
+	if err != nil {
+		log.Fatal("error to create queue service: ", err)
+	}
+
+	for {
+		messages, err := queueService.Consume(services.FanOutActivities)
+		if err != nil {
+			log.Fatal("error to consume message from queue: ", err)
+		}
+
+		for message := range messages {
+			var task models.ActivityTask
+			if err := jsoniter.Unmarshal(message, &task); err != nil {
+				log.Println("error unmarshalling activity task: ", err)
+				continue
+			}
+
+			pushed, err := feedService.FanOutActivity(ctx, task)
+			if err != nil {
+				log.Printf("error to fan out activity %s: %s", task.ActivityID, err.Error())
+				continue
+			}
+
+			log.Printf("activity %s pushed to %d feeds", task.ActivityID, pushed)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/G-Villarinho/book-wise-api/clients"
+	"github.com/G-Villarinho/book-wise-api/config"
+	"github.com/G-Villarinho/book-wise-api/database"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/repositories"
+	"github.com/G-Villarinho/book-wise-api/services"
+	"github.com/G-Villarinho/book-wise-api/storage"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultReconcileInterval  = 24 * time.Hour
+	defaultOrphanSafetyWindow = 24 * time.Hour
+)
+
+func main() {
+	config.ConfigureLogger()
+	config.LoadEnvironments()
+
+	di := internal.NewDi()
+
+	ctx := context.Background()
+	db, err := database.NewMysqlConnection(ctx)
+	if err != nil {
+		log.Fatal("error to connect to database: ", err)
+	}
+
+	rabbitMQClient, err := clients.NewRabbitMQClient(di)
+	if err != nil {
+		log.Fatal("error initializing RabbitMQ client: ", err)
+	}
+
+	if err := rabbitMQClient.Connect(); err != nil {
+		log.Fatal("error connecting to RabbitMQ: ", err)
+	}
+	defer func() {
+		if err := rabbitMQClient.Disconnect(); err != nil {
+			log.Println("error disconnecting from RabbitMQ:", err)
+		}
+	}()
+
+	internal.Provide(di, func(d *internal.Di) (clients.RabbitMQClient, error) {
+		return rabbitMQClient, nil
+	})
+
+	internal.Provide(di, func(d *internal.Di) (*gorm.DB, error) {
+		return db, nil
+	})
+
+	internal.Provide(di, clients.NewCloudFlareImageClient)
+	internal.Provide(di, storage.NewImageStorage)
+	internal.Provide(di, services.NewQueueService)
+	internal.Provide(di, services.NewImageService)
+	internal.Provide(di, repositories.NewImageRepository)
+
+	imageService, err := internal.Invoke[services.ImageService](di)
+	if err != nil {
+		log.Fatal("error to create image service: ", err)
+	}
+
+	interval := defaultReconcileInterval
+	if config.Env.ImageReconciliation.Interval > 0 {
+		interval = time.Duration(config.Env.ImageReconciliation.Interval) * time.Hour
+	}
+
+	safetyWindow := defaultOrphanSafetyWindow
+	if config.Env.ImageReconciliation.SafetyWindow > 0 {
+		safetyWindow = time.Duration(config.Env.ImageReconciliation.SafetyWindow) * time.Hour
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		deleted, err := imageService.DeleteOrphanImages(ctx, safetyWindow)
+		if err != nil {
+			log.Printf("error to reconcile images %s", err.Error())
+		} else {
+			log.Printf("image reconciliation finished, %d orphan images deleted", deleted)
+		}
+
+		<-ticker.C
+	}
+}
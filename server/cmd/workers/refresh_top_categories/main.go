@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/G-Villarinho/book-wise-api/cache"
+	"github.com/G-Villarinho/book-wise-api/config"
+	"github.com/G-Villarinho/book-wise-api/database"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/repositories"
+	"github.com/G-Villarinho/book-wise-api/services"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+)
+
+func main() {
+	config.ConfigureLogger()
+	config.LoadEnvironments()
+
+	di := internal.NewDi()
+
+	ctx := context.Background()
+	db, err := database.NewMysqlConnection(ctx)
+	if err != nil {
+		log.Fatal("error to connect to database: ", err)
+	}
+
+	redisClient, err := database.NewRedisConnection(ctx)
+	if err != nil {
+		log.Fatal("error to connect to redis: ", err)
+	}
+
+	internal.Provide(di, func(d *internal.Di) (*gorm.DB, error) {
+		return db, nil
+	})
+
+	internal.Provide(di, func(d *internal.Di) (*redis.Client, error) {
+		return redisClient, nil
+	})
+
+	internal.Provide(di, cache.NewRedisCache)
+	internal.Provide(di, repositories.NewBookRepository)
+	internal.Provide(di, repositories.NewCategoryRepository)
+	internal.Provide(di, repositories.NewFollowRepository)
+	internal.Provide(di, services.NewCategoryService)
+
+	categoryService, err := internal.Invoke[services.CategoryService](di)
+	if err != nil {
+		log.Fatal("error to create category service: ", err)
+	}
+
+	ticker := time.NewTicker(services.GetTopCategoriesRefreshInterval())
+	defer ticker.Stop()
+
+	for {
+		topCategories, err := categoryService.RefreshTopCategories(ctx)
+		if err != nil {
+			log.Printf("error to refresh top categories %s", err.Error())
+		} else {
+			log.Printf("top categories refreshed, %d categories ranked", len(topCategories))
+		}
+
+		<-ticker.C
+	}
+}
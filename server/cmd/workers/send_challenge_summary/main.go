@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/G-Villarinho/book-wise-api/clients"
+	"github.com/G-Villarinho/book-wise-api/config"
+	"github.com/G-Villarinho/book-wise-api/database"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/repositories"
+	"github.com/G-Villarinho/book-wise-api/services"
+	"gorm.io/gorm"
+)
+
+func main() {
+	config.ConfigureLogger()
+	config.LoadEnvironments()
+
+	di := internal.NewDi()
+
+	ctx := context.Background()
+	db, err := database.NewMysqlConnection(ctx)
+	if err != nil {
+		log.Fatal("error to connect to database: ", err)
+	}
+
+	rabbitMQClient, err := clients.NewRabbitMQClient(di)
+	if err != nil {
+		log.Fatal("error initializing RabbitMQ client: ", err)
+	}
+
+	if err := rabbitMQClient.Connect(); err != nil {
+		log.Fatal("error connecting to RabbitMQ: ", err)
+	}
+	defer func() {
+		if err := rabbitMQClient.Disconnect(); err != nil {
+			log.Println("error disconnecting from RabbitMQ:", err)
+		}
+	}()
+
+	internal.Provide(di, func(d *internal.Di) (clients.RabbitMQClient, error) {
+		return rabbitMQClient, nil
+	})
+
+	internal.Provide(di, func(d *internal.Di) (*gorm.DB, error) {
+		return db, nil
+	})
+
+	internal.Provide(di, services.NewQueueService)
+	internal.Provide(di, services.NewReadingChallengeService)
+	internal.Provide(di, repositories.NewBookShelfRepository)
+	internal.Provide(di, repositories.NewReadingChallengeRepository)
+
+	readingChallengeService, err := internal.Invoke[services.ReadingChallengeService](di)
+	if err != nil {
+		log.Fatal("error to create reading challenge service: ", err)
+	}
+
+	ticker := time.NewTicker(services.GetReadingChallengeSummaryInterval())
+	defer ticker.Stop()
+
+	for {
+		sent, err := readingChallengeService.SendYearEndSummaries(ctx)
+		if err != nil {
+			log.Printf("error to send reading challenge summaries %s", err.Error())
+		} else {
+			log.Printf("%d reading challenge summaries sent", sent)
+		}
+
+		<-ticker.C
+	}
+}
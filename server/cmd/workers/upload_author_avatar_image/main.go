@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/G-Villarinho/book-wise-api/cache"
 	"github.com/G-Villarinho/book-wise-api/clients"
 	"github.com/G-Villarinho/book-wise-api/config"
 	"github.com/G-Villarinho/book-wise-api/database"
@@ -12,6 +13,8 @@ import (
 	"github.com/G-Villarinho/book-wise-api/models"
 	"github.com/G-Villarinho/book-wise-api/repositories"
 	"github.com/G-Villarinho/book-wise-api/services"
+	"github.com/G-Villarinho/book-wise-api/storage"
+	"github.com/go-redis/redis/v8"
 	"github.com/google/uuid"
 	jsoniter "github.com/json-iterator/go"
 	"gorm.io/gorm"
@@ -29,6 +32,11 @@ func main() {
 		log.Fatal("error to connect to database: ", err)
 	}
 
+	redisClient, err := database.NewRedisConnection(ctx)
+	if err != nil {
+		log.Fatal("error to connect to redis: ", err)
+	}
+
 	rabbitMQClient, err := clients.NewRabbitMQClient(di)
 	if err != nil {
 		log.Fatal("error initializing RabbitMQ client: ", err)
@@ -51,10 +59,18 @@ func main() {
 		return db, nil
 	})
 
+	internal.Provide(di, func(d *internal.Di) (*redis.Client, error) {
+		return redisClient, nil
+	})
+
+	internal.Provide(di, cache.NewRedisCache)
 	internal.Provide(di, clients.NewCloudFlareImageClient)
+	internal.Provide(di, storage.NewImageStorage)
 	internal.Provide(di, services.NewQueueService)
 	internal.Provide(di, services.NewImageService)
+	internal.Provide(di, services.NewEventService)
 	internal.Provide(di, repositories.NewAuthorRepository)
+	internal.Provide(di, repositories.NewImageRepository)
 
 	imageService, err := internal.Invoke[services.ImageService](di)
 	if err != nil {
@@ -66,6 +82,11 @@ func main() {
 		log.Fatal("error to create queue service: ", err)
 	}
 
+	eventService, err := internal.Invoke[services.EventService](di)
+	if err != nil {
+		log.Fatal("error to create event service: ", err)
+	}
+
 	authorRepository, err := internal.Invoke[repositories.AuthorRepository](di)
 	if err != nil {
 		log.Fatal("error to create author repository: ", err)
@@ -84,19 +105,43 @@ func main() {
 				continue
 			}
 
+			author, err := authorRepository.GetAuthorByID(ctx, task.RecordID)
+			if err != nil {
+				log.Printf("error to get author %s", err.Error())
+				continue
+			}
+
+			if author == nil {
+				log.Printf("author %s not found", task.RecordID)
+				continue
+			}
+
 			imageName := fmt.Sprintf("author_avatar_%s", uuid.New().String())
 
-			response, err := imageService.UploadImage(ctx, imageName, task)
+			variants, err := imageService.UploadImageVariants(ctx, imageName, models.AvatarImage, task.Image)
 			if err != nil {
 				log.Printf("error to upload image %s", err.Error())
 				continue
 			}
 
-			if err := authorRepository.UpdateAuthorAvatar(ctx, task.RecordID, response.ID, response.URL); err != nil {
+			if err := authorRepository.UpdateAuthorAvatar(ctx, task.RecordID, variants); err != nil {
 				log.Printf("error to update author image %s", err.Error())
 				continue
 			}
 
+			if err := imageService.ScheduleImageDeletion(author.AvatarImageIDs()); err != nil {
+				log.Printf("error to schedule old avatar deletion %s", err.Error())
+			}
+
+			event := map[string]any{
+				"authorId":       task.RecordID,
+				"avatarVariants": variants.URLs(),
+			}
+
+			if err := eventService.PublishToRoles(ctx, []models.Role{models.Admin, models.Owner}, models.AuthorAvatarUploadedEvent, event); err != nil {
+				log.Printf("error to publish avatar uploaded event %s", err.Error())
+			}
+
 			log.Println("image sent successfully")
 		}
 	}
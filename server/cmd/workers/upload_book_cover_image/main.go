@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/G-Villarinho/book-wise-api/clients"
+	"github.com/G-Villarinho/book-wise-api/config"
+	"github.com/G-Villarinho/book-wise-api/database"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/repositories"
+	"github.com/G-Villarinho/book-wise-api/services"
+	"github.com/G-Villarinho/book-wise-api/storage"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"gorm.io/gorm"
+)
+
+func main() {
+	config.ConfigureLogger()
+	config.LoadEnvironments()
+
+	di := internal.NewDi()
+
+	ctx := context.Background()
+	db, err := database.NewMysqlConnection(ctx)
+	if err != nil {
+		log.Fatal("error to connect to database: ", err)
+	}
+
+	rabbitMQClient, err := clients.NewRabbitMQClient(di)
+	if err != nil {
+		log.Fatal("error initializing RabbitMQ client: ", err)
+	}
+
+	if err := rabbitMQClient.Connect(); err != nil {
+		log.Fatal("error connecting to RabbitMQ: ", err)
+	}
+	defer func() {
+		if err := rabbitMQClient.Disconnect(); err != nil {
+			log.Println("error disconnecting from RabbitMQ:", err)
+		}
+	}()
+
+	internal.Provide(di, func(d *internal.Di) (clients.RabbitMQClient, error) {
+		return rabbitMQClient, nil
+	})
+
+	internal.Provide(di, func(d *internal.Di) (*gorm.DB, error) {
+		return db, nil
+	})
+
+	internal.Provide(di, clients.NewCloudFlareImageClient)
+	internal.Provide(di, clients.NewImageDownloaderClient)
+	internal.Provide(di, storage.NewImageStorage)
+	internal.Provide(di, services.NewQueueService)
+	internal.Provide(di, services.NewImageService)
+	internal.Provide(di, repositories.NewBookRepository)
+	internal.Provide(di, repositories.NewImageRepository)
+
+	imageService, err := internal.Invoke[services.ImageService](di)
+	if err != nil {
+		log.Fatal("error to create image service: ", err)
+	}
+
+	queueService, err := internal.Invoke[services.QueueService](di)
+	if err != nil {
+		log.Fatal("error to create queue service: ", err)
+	}
+
+	imageDownloaderClient, err := internal.Invoke[clients.ImageDownloaderClient](di)
+	if err != nil {
+		log.Fatal("error to create image downloader client: ", err)
+	}
+
+	bookRepository, err := internal.Invoke[repositories.BookRepository](di)
+	if err != nil {
+		log.Fatal("error to create book repository: ", err)
+	}
+
+	for {
+		messages, err := queueService.Consume(services.UploadBookCover)
+		if err != nil {
+			log.Fatal("error to consume message from queue: ", err)
+		}
+
+		for message := range messages {
+			var task models.BookCoverTask
+			if err := jsoniter.Unmarshal(message, &task); err != nil {
+				log.Println("error unmarshalling book cover task: ", err)
+				continue
+			}
+
+			book, err := bookRepository.GetBookByID(ctx, task.BookID, false)
+			if err != nil {
+				log.Printf("error to get book %s", err.Error())
+				continue
+			}
+
+			if book == nil {
+				log.Printf("book %s not found", task.BookID)
+				continue
+			}
+
+			image := task.Image
+			if len(image) == 0 {
+				image, err = imageDownloaderClient.DownloadImage(ctx, task.SourceURL)
+				if err != nil {
+					log.Printf("error to download cover %s: %s", task.SourceURL, err.Error())
+					continue
+				}
+			}
+
+			imageName := fmt.Sprintf("book_cover_%s", uuid.New().String())
+
+			variants, err := imageService.UploadImageVariants(ctx, imageName, models.CoverImage, image)
+			if err != nil {
+				log.Printf("error to upload image %s", err.Error())
+				continue
+			}
+
+			if err := bookRepository.UpdateBookCover(ctx, task.BookID, variants); err != nil {
+				log.Printf("error to update book cover %s", err.Error())
+				continue
+			}
+
+			if err := imageService.ScheduleImageDeletion(book.CoverImageIDs()); err != nil {
+				log.Printf("error to schedule old cover deletion %s", err.Error())
+			}
+
+			log.Println("cover sent successfully")
+		}
+	}
+
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/G-Villarinho/book-wise-api/cache"
+	"github.com/G-Villarinho/book-wise-api/clients"
+	"github.com/G-Villarinho/book-wise-api/config"
+	"github.com/G-Villarinho/book-wise-api/database"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/repositories"
+	"github.com/G-Villarinho/book-wise-api/services"
+	"github.com/G-Villarinho/book-wise-api/storage"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+	"gorm.io/gorm"
+)
+
+func main() {
+	config.ConfigureLogger()
+	config.LoadEnvironments()
+
+	di := internal.NewDi()
+
+	ctx := context.Background()
+	db, err := database.NewMysqlConnection(ctx)
+	if err != nil {
+		log.Fatal("error to connect to database: ", err)
+	}
+
+	redisClient, err := database.NewRedisConnection(ctx)
+	if err != nil {
+		log.Fatal("error to connect to redis: ", err)
+	}
+
+	rabbitMQClient, err := clients.NewRabbitMQClient(di)
+	if err != nil {
+		log.Fatal("error initializing RabbitMQ client: ", err)
+	}
+
+	if err := rabbitMQClient.Connect(); err != nil {
+		log.Fatal("error connecting to RabbitMQ: ", err)
+	}
+	defer func() {
+		if err := rabbitMQClient.Disconnect(); err != nil {
+			log.Println("error disconnecting from RabbitMQ:", err)
+		}
+	}()
+
+	internal.Provide(di, func(d *internal.Di) (clients.RabbitMQClient, error) {
+		return rabbitMQClient, nil
+	})
+
+	internal.Provide(di, func(d *internal.Di) (*gorm.DB, error) {
+		return db, nil
+	})
+
+	internal.Provide(di, func(d *internal.Di) (*redis.Client, error) {
+		return redisClient, nil
+	})
+
+	internal.Provide(di, cache.NewRedisCache)
+	internal.Provide(di, clients.NewCloudFlareImageClient)
+	internal.Provide(di, storage.NewImageStorage)
+	internal.Provide(di, services.NewQueueService)
+	internal.Provide(di, services.NewImageService)
+	internal.Provide(di, repositories.NewUserRepository)
+	internal.Provide(di, repositories.NewImageRepository)
+
+	imageService, err := internal.Invoke[services.ImageService](di)
+	if err != nil {
+		log.Fatal("error to create image service: ", err)
+	}
+
+	queueService, err := internal.Invoke[services.QueueService](di)
+	if err != nil {
+		log.Fatal("error to create queue service: ", err)
+	}
+
+	cacheService, err := internal.Invoke[cache.CacheService](di)
+	if err != nil {
+		log.Fatal("error to create cache service: ", err)
+	}
+
+	userRepository, err := internal.Invoke[repositories.UserRepository](di)
+	if err != nil {
+		log.Fatal("error to create user repository: ", err)
+	}
+
+	for {
+		messages, err := queueService.Consume(services.UploadUserImage)
+		if err != nil {
+			log.Fatal("error to consume message from queue: ", err)
+		}
+
+		for message := range messages {
+			var task models.ImageUploadTask
+			if err := jsoniter.Unmarshal(message, &task); err != nil {
+				log.Println("error unmarshalling upload image task: ", err)
+				continue
+			}
+
+			user, err := userRepository.GetUserByID(ctx, task.RecordID, nil)
+			if err != nil {
+				log.Printf("error to get user %s", err.Error())
+				continue
+			}
+
+			if user == nil {
+				log.Printf("user %s not found", task.RecordID)
+				continue
+			}
+
+			imageName := fmt.Sprintf("user_avatar_%s", uuid.New().String())
+
+			variants, err := imageService.UploadImageVariants(ctx, imageName, models.AvatarImage, task.Image)
+			if err != nil {
+				log.Printf("error to upload image %s", err.Error())
+				continue
+			}
+
+			if err := userRepository.UpdateUserAvatar(ctx, task.RecordID, variants); err != nil {
+				log.Printf("error to update user image %s", err.Error())
+				continue
+			}
+
+			if err := imageService.ScheduleImageDeletion(user.AvatarImageIDs()); err != nil {
+				log.Printf("error to schedule old avatar deletion %s", err.Error())
+			}
+
+			if err := cacheService.Delete(ctx, services.GetUserKey(task.RecordID)); err != nil {
+				log.Printf("error to invalidate user cache %s", err.Error())
+			}
+
+			log.Println("image sent successfully")
+		}
+	}
+
+}
@@ -1,22 +1,31 @@
 package models
 
+import "strings"
+
 type Environment struct {
-	PrivateKey        string `env:"PRIVATE_KEY"`
-	PublicKey         string `env:"PUBLIC_KEY"`
-	Redis             RedisEnvironment
-	CloudFlare        CloudFlareEnvironment
-	Cache             CacheEnvironment
-	Email             EmailEnvironment
-	APIBaseURL        string `env:"API_BASE_URL"`
-	RedirectAdminURL  string `env:"REDIRECT_ADMIN_URL"`
-	RedirectMemberURL string `env:"REDIRECT_MEMBER_URL"`
-	CookieName        string `env:"COOKIE_NAME"`
-	RabbitMQURL       string `env:"RABBITMQ_URL"`
-	APIPort           int    `env:"API_PORT"`
-	ConnectionString  string `env:"CONNECTION_STRING"`
-	AdminFrontURL     string `env:"ADMIN_FRONT_URL"`
-	MemberFrontURL    string `env:"MEMBER_FRONT_URL"`
-	GoogleBooksApiUrl string `env:"GOOGLE_BOOKS_URL_API"`
+	PrivateKey          string `env:"PRIVATE_KEY"`
+	PublicKey           string `env:"PUBLIC_KEY"`
+	Redis               RedisEnvironment
+	CloudFlare          CloudFlareEnvironment
+	ImageStorage        ImageStorageEnvironment
+	ImageReconciliation ImageReconciliationEnvironment
+	TopCategories       TopCategoriesEnvironment
+	NotificationDigest  NotificationDigestEnvironment
+	ContentFilter       ContentFilterEnvironment
+	ReadingChallenge    ReadingChallengeEnvironment
+	Feed                FeedEnvironment
+	Cache               CacheEnvironment
+	Email               EmailEnvironment
+	APIBaseURL          string `env:"API_BASE_URL"`
+	RedirectAdminURL    string `env:"REDIRECT_ADMIN_URL"`
+	RedirectMemberURL   string `env:"REDIRECT_MEMBER_URL"`
+	CookieName          string `env:"COOKIE_NAME"`
+	RabbitMQURL         string `env:"RABBITMQ_URL"`
+	APIPort             int    `env:"API_PORT"`
+	ConnectionString    string `env:"CONNECTION_STRING"`
+	AdminFrontURL       string `env:"ADMIN_FRONT_URL"`
+	MemberFrontURL      string `env:"MEMBER_FRONT_URL"`
+	GoogleBooksApiUrl   string `env:"GOOGLE_BOOKS_URL_API"`
 }
 
 type RedisEnvironment struct {
@@ -26,8 +35,44 @@ type RedisEnvironment struct {
 }
 
 type CloudFlareEnvironment struct {
-	CloudFlareImageApiUrl string `env:"CLOUD_FLARE_IMAGE_API_URL"`
-	CloudFlareImageApiKey string `env:"CLOUD_FLARE_IMAGE_API_KEY"`
+	CloudFlareImageApiUrl      string `env:"CLOUD_FLARE_IMAGE_API_URL"`
+	CloudFlareImageApiKey      string `env:"CLOUD_FLARE_IMAGE_API_KEY"`
+	CloudFlareImageDeliveryUrl string `env:"CLOUD_FLARE_IMAGE_DELIVERY_URL"`
+	CloudFlareImageOwner       string `env:"CLOUD_FLARE_IMAGE_OWNER"`
+}
+
+type ImageStorageEnvironment struct {
+	Driver    string `env:"IMAGE_STORAGE_DRIVER"`
+	LocalPath string `env:"IMAGE_STORAGE_LOCAL_PATH"`
+}
+
+type ImageReconciliationEnvironment struct {
+	Interval     int `env:"IMAGE_RECONCILE_INTERVAL"`
+	SafetyWindow int `env:"IMAGE_ORPHAN_SAFETY_WINDOW"`
+}
+
+type TopCategoriesEnvironment struct {
+	WindowDays      int `env:"TOP_CATEGORIES_WINDOW_DAYS"`
+	RefreshInterval int `env:"TOP_CATEGORIES_REFRESH_INTERVAL"`
+}
+
+type NotificationDigestEnvironment struct {
+	Interval int `env:"NOTIFICATION_DIGEST_INTERVAL"`
+}
+
+type ContentFilterEnvironment struct {
+	BannedWords   CommaSeparatedList `env:"CONTENT_FILTER_BANNED_WORDS"`
+	MaxLinks      int                `env:"CONTENT_FILTER_MAX_LINKS"`
+	MaxRepetition int                `env:"CONTENT_FILTER_MAX_REPETITION"`
+}
+
+type ReadingChallengeEnvironment struct {
+	SummaryInterval int `env:"READING_CHALLENGE_SUMMARY_INTERVAL"`
+}
+
+type FeedEnvironment struct {
+	ActiveDays int `env:"FEED_ACTIVE_DAYS"`
+	MaxSize    int `env:"FEED_MAX_SIZE"`
 }
 
 type CacheEnvironment struct {
@@ -42,3 +87,18 @@ type EmailEnvironment struct {
 	EmailClientBaseURL string `env:"EMAIL_CLIENT_BASE_URL"`
 	EmailSender        string `env:"EMAIL_SENDER"`
 }
+
+// CommaSeparatedList reads a comma-separated variable into a slice. go-env
+// splits its tag options on commas, so "separator=," cannot be set there.
+type CommaSeparatedList []string
+
+func (c *CommaSeparatedList) UnmarshalEnvironmentValue(data string) error {
+	*c = nil
+	for _, value := range strings.Split(data, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			*c = append(*c, value)
+		}
+	}
+
+	return nil
+}
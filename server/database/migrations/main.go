@@ -2,14 +2,22 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/G-Villarinho/book-wise-api/config"
 	"github.com/G-Villarinho/book-wise-api/database"
 	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+const legacyCategorySlugIndex = "idx_Categories_Slug"
+
 func main() {
 	config.LoadEnvironments()
 
@@ -21,16 +29,236 @@ func main() {
 		log.Fatal("error to connect to mysql: ", err)
 	}
 
+	if err := prepareCategorySlugIndex(db); err != nil {
+		log.Fatal("error to prepare category slug index: ", err)
+	}
+
 	if err := db.AutoMigrate(
 		&models.User{},
 		&models.Book{},
 		&models.Author{},
 		&models.Category{},
+		&models.CategoryAlias{},
 		&models.Evaluation{},
+		&models.EvaluationLike{},
+		&models.EvaluationReport{},
+		&models.Comment{},
+		&models.BookShelf{},
+		&models.BookList{},
+		&models.BookListItem{},
+		&models.ReadingSession{},
+		&models.ReadingChallenge{},
+		&models.Follow{},
+		&models.Activity{},
+		&models.Notification{},
 	); err != nil {
 		log.Fatal("error to migrate: ", err)
 	}
 
+	if err := backfillCategoryHierarchy(db); err != nil {
+		log.Fatal("error to backfill category hierarchy: ", err)
+	}
+
+	if err := backfillCategorySlugs(db); err != nil {
+		log.Fatal("error to backfill category slugs: ", err)
+	}
+
 	log.Println("Migration executed successfully")
+}
+
+// prepareCategorySlugIndex fills the missing slugs before AutoMigrate turns
+// the slug index unique, which would fail on the empty slugs of older rows,
+// and drops the non-unique index it replaces.
+func prepareCategorySlugIndex(db *gorm.DB) error {
+	migrator := db.Migrator()
+	if !migrator.HasTable(&models.Category{}) {
+		return nil
+	}
+
+	if !migrator.HasColumn(&models.Category{}, "Slug") {
+		if err := migrator.AddColumn(&models.Category{}, "Slug"); err != nil {
+			return err
+		}
+	}
+
+	if err := backfillCategorySlugs(db); err != nil {
+		return err
+	}
+
+	if migrator.HasIndex(&models.Category{}, legacyCategorySlugIndex) {
+		if err := migrator.DropIndex(&models.Category{}, legacyCategorySlugIndex); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func backfillCategorySlugs(db *gorm.DB) error {
+	var categories []models.Category
+	if err := db.Unscoped().Find(&categories).Error; err != nil {
+		return err
+	}
+
+	taken := takenCategorySlugs(categories)
+	for _, category := range categories {
+		if category.Slug != "" {
+			continue
+		}
+
+		slug := uniqueCategorySlug(category.Name, taken)
+		if err := db.Unscoped().Model(&models.Category{}).Where("Id = ?", category.ID).Update("Slug", slug).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// backfillCategoryHierarchy turns the flat categories created before
+// subcategories existed, e.g. "Fiction / Literary", into a path of nested
+// categories. Their NormalizedName was built by stripping the "/", so they
+// would never match the path lookups done when categories are resolved.
+func backfillCategoryHierarchy(db *gorm.DB) error {
+	var categories []models.Category
+	if err := db.Unscoped().Find(&categories).Error; err != nil {
+		return err
+	}
+
+	taken := takenCategorySlugs(categories)
+	byNormalizedName := make(map[string]models.Category, len(categories))
+	for _, category := range categories {
+		byNormalizedName[category.NormalizedName] = category
+	}
+
+	for _, category := range categories {
+		segments := models.SplitCategoryPath(category.Name)
+		if category.ParentID != nil || len(segments) < 2 {
+			continue
+		}
+
+		var parentID *uuid.UUID
+		for depth := 1; depth < len(segments); depth++ {
+			normalizedName := models.NormalizeCategoryPath(segments[:depth])
+
+			parent, exists := byNormalizedName[normalizedName]
+			if !exists {
+				ID, err := uuid.NewV7()
+				if err != nil {
+					return err
+				}
+
+				parent = models.Category{
+					BaseModel: models.BaseModel{
+						ID: ID,
+					},
+					ParentID:       parentID,
+					Name:           segments[depth-1],
+					NormalizedName: normalizedName,
+					Slug:           uniqueCategorySlug(strings.Join(segments[:depth], " "), taken),
+				}
+
+				if err := db.Create(&parent).Error; err != nil {
+					return err
+				}
+
+				byNormalizedName[normalizedName] = parent
+			}
+
+			ID := parent.ID
+			parentID = &ID
+		}
+
+		normalizedName := models.NormalizeCategoryPath(segments)
+		if existing, exists := byNormalizedName[normalizedName]; exists && existing.ID != category.ID {
+			if err := mergeLegacyCategory(db, category, existing.ID); err != nil {
+				return err
+			}
+			continue
+		}
+
+		updates := map[string]any{
+			"ParentId":       parentID,
+			"Name":           segments[len(segments)-1],
+			"NormalizedName": normalizedName,
+		}
+
+		if category.Slug == "" {
+			updates["Slug"] = uniqueCategorySlug(strings.Join(segments, " "), taken)
+		}
+
+		if err := db.Unscoped().Model(&models.Category{}).Where("Id = ?", category.ID).Updates(updates).Error; err != nil {
+			return err
+		}
+
+		byNormalizedName[normalizedName] = category
+	}
+
+	return nil
+}
+
+// mergeLegacyCategory moves the books of a flat category into the nested
+// category that already has its path, keeping the old name as an alias.
+func mergeLegacyCategory(db *gorm.DB, source models.Category, targetID uuid.UUID) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`
+			UPDATE BookCategories SET CategoryID = ?
+			WHERE CategoryID = ?
+			AND BookID NOT IN (
+				SELECT BookID FROM (SELECT BookID FROM BookCategories WHERE CategoryID = ?) AS TargetBooks
+			)`, targetID, source.ID, targetID).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Exec("DELETE FROM BookCategories WHERE CategoryID = ?", source.ID).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.CategoryAlias{}).
+			Where("CategoryId = ?", source.ID).
+			Update("CategoryId", targetID).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Where("Id = ?", source.ID).Delete(&models.Category{}).Error; err != nil {
+			return err
+		}
+
+		alias := models.CategoryAlias{
+			NormalizedName: source.NormalizedName,
+			CategoryID:     targetID,
+		}
+
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&alias).Error
+	})
+}
+
+func takenCategorySlugs(categories []models.Category) map[string]struct{} {
+	taken := make(map[string]struct{})
+	for _, category := range categories {
+		if category.Slug != "" {
+			taken[category.Slug] = struct{}{}
+		}
+	}
+
+	return taken
+}
+
+func uniqueCategorySlug(name string, taken map[string]struct{}) string {
+	base := utils.GenerateSlug(name)
+	if base == "" {
+		base = "categoria"
+	}
+
+	slug := base
+	for suffix := 2; ; suffix++ {
+		if _, exists := taken[slug]; !exists {
+			break
+		}
+
+		slug = fmt.Sprintf("%s-%d", base, suffix)
+	}
 
+	taken[slug] = struct{}{}
+	return slug
 }
@@ -4,4 +4,5 @@ type ContextKey string
 
 const (
 	SessionKey ContextKey = "session"
+	LocaleKey  ContextKey = "locale"
 )
@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/labstack/echo/v4"
+)
+
+func ResolveLocale() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			acceptLanguage := ctx.QueryParam("locale")
+			if acceptLanguage == "" {
+				acceptLanguage = ctx.Request().Header.Get("Accept-Language")
+			}
+
+			locale := models.ResolveLocale(acceptLanguage)
+			ctx.SetRequest(ctx.Request().WithContext(context.WithValue(ctx.Request().Context(), internal.LocaleKey, locale)))
+
+			return next(ctx)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MaxFeedLimit     = 50
+	DefaultFeedLimit = 20
+)
+
+var (
+	ErrInvalidFeedCursor = errors.New("invalid feed cursor")
+)
+
+type ActivityType string
+
+const (
+	EvaluationCreatedActivity ActivityType = "evaluation_created"
+	BookFinishedActivity      ActivityType = "book_finished"
+	BookListCreatedActivity   ActivityType = "book_list_created"
+)
+
+type Activity struct {
+	BaseModel
+	ActorID      uuid.UUID    `gorm:"column:ActorId;type:char(36);not null;index:idx_activities_actor"`
+	Type         ActivityType `gorm:"column:Type;type:varchar(30);not null"`
+	BookID       *uuid.UUID   `gorm:"column:BookId;type:char(36);null;default:null;index"`
+	EvaluationID *uuid.UUID   `gorm:"column:EvaluationId;type:char(36);null;default:null;index"`
+	BookListID   *uuid.UUID   `gorm:"column:BookListId;type:char(36);null;default:null;index"`
+	Actor        User         `gorm:"foreignKey:ActorID;references:ID"`
+	Book         *Book        `gorm:"foreignKey:BookID;references:ID"`
+	Evaluation   *Evaluation  `gorm:"foreignKey:EvaluationID;references:ID"`
+	BookList     *BookList    `gorm:"foreignKey:BookListID;references:ID"`
+}
+
+func (a *Activity) TableName() string {
+	return "Activities"
+}
+
+type ActivityTask struct {
+	ActivityID uuid.UUID `json:"activityId"`
+	ActorID    uuid.UUID `json:"actorId"`
+}
+
+// FeedPagination pages through the feed by activity ID. Activity IDs are
+// UUIDv7, so ordering by ID is ordering by creation time.
+type FeedPagination struct {
+	Cursor *uuid.UUID `json:"cursor"`
+	Limit  int        `json:"limit"`
+}
+
+type ActivityResponse struct {
+	ID         string                       `json:"id"`
+	Type       ActivityType                 `json:"type"`
+	Actor      PublicUserResponse           `json:"actor"`
+	Book       *BookSummaryResponse         `json:"book,omitempty"`
+	Evaluation *EvaluationBasicInfoResponse `json:"evaluation,omitempty"`
+	BookList   *BookListResponse            `json:"bookList,omitempty"`
+	CreatedAt  time.Time                    `json:"createdAt"`
+}
+
+type FeedResponse struct {
+	Data       []*ActivityResponse `json:"data"`
+	NextCursor *string             `json:"nextCursor"`
+}
+
+func newActivity(actorID uuid.UUID, activityType ActivityType) *Activity {
+	ID, _ := uuid.NewV7()
+
+	return &Activity{
+		BaseModel: BaseModel{
+			ID:        ID,
+			CreatedAt: time.Now().UTC(),
+		},
+		ActorID: actorID,
+		Type:    activityType,
+	}
+}
+
+func NewEvaluationCreatedActivity(evaluation *Evaluation) *Activity {
+	activity := newActivity(evaluation.UserID, EvaluationCreatedActivity)
+	activity.BookID = &evaluation.BookID
+	activity.EvaluationID = &evaluation.ID
+	return activity
+}
+
+func NewBookFinishedActivity(userID, bookID uuid.UUID) *Activity {
+	activity := newActivity(userID, BookFinishedActivity)
+	activity.BookID = &bookID
+	return activity
+}
+
+func NewBookListCreatedActivity(bookList *BookList) *Activity {
+	activity := newActivity(bookList.UserID, BookListCreatedActivity)
+	activity.BookListID = &bookList.ID
+	return activity
+}
+
+// IsViewableBy reports whether the content the activity points to is still
+// visible to the viewer. Activities are never rewritten, so hidden reviews,
+// unpublished books, lists made private and activities the actor's privacy
+// settings now hide are dropped when the feed is read.
+func (a *Activity) IsViewableBy(viewerID uuid.UUID) bool {
+	switch a.Type {
+	case EvaluationCreatedActivity:
+		return a.Actor.AreReviewsVisibleTo(viewerID) && a.Evaluation != nil && a.Evaluation.Status == VisibleEvaluation && a.Book != nil && a.Book.Published
+	case BookFinishedActivity:
+		return a.Actor.AreShelvesVisibleTo(viewerID) && a.Book != nil && a.Book.Published
+	case BookListCreatedActivity:
+		return a.Actor.IsProfileVisibleTo(viewerID) && a.BookList != nil && a.BookList.Visibility == PublicBookList
+	}
+
+	return false
+}
+
+func (a *Activity) ToActivityResponse(viewerID uuid.UUID) *ActivityResponse {
+	response := &ActivityResponse{
+		ID:        a.ID.String(),
+		Type:      a.Type,
+		Actor:     *a.Actor.ToPublicUserResponse(viewerID),
+		CreatedAt: a.CreatedAt,
+	}
+
+	if a.Book != nil {
+		response.Book = a.Book.ToBookSummaryResponse()
+	}
+
+	if a.Evaluation != nil {
+		a.Evaluation.User = a.Actor
+		response.Evaluation = a.Evaluation.ToEvaluationBasicInfoResponse(viewerID)
+	}
+
+	if a.BookList != nil {
+		a.BookList.User = a.Actor
+		response.BookList = a.BookList.ToBookListResponse(viewerID)
+	}
+
+	return response
+}
+
+func NewFeedPagination(cursor, limit string) (*FeedPagination, error) {
+	parsedLimit, err := ParseLimit(limit, DefaultFeedLimit, MaxFeedLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination := &FeedPagination{
+		Limit: parsedLimit,
+	}
+
+	if cursor != "" {
+		ID, err := uuid.Parse(cursor)
+		if err != nil {
+			return nil, ErrInvalidFeedCursor
+		}
+		pagination.Cursor = &ID
+	}
+
+	return pagination, nil
+}
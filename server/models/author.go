@@ -14,6 +14,7 @@ var (
 	ErrAuthorsNotFound = errors.New("no authors found in database")
 	ErrAuthorNotFound  = errors.New("no author found in database")
 	ErrAuthorsMismatch = errors.New("authors mismatch: not all provided authors were found")
+	ErrMergeSameAuthor = errors.New("cannot merge an author into itself")
 )
 
 type Author struct {
@@ -21,6 +22,7 @@ type Author struct {
 	FullName            string         `gorm:"column:FullName;type:varchar(255);not null"`
 	AvatarURL           sql.NullString `gorm:"column:AvatarUrl;type:varchar(355);null;default:null"`
 	AvatarImageClientID uuid.UUID      `gorm:"column:AvatarImageClientId;type:char(36);not null;index"`
+	AvatarVariants      ImageVariants  `gorm:"column:AvatarVariants;type:json"`
 	Nationality         string         `gorm:"column:Nationality;type:varchar(70);not null"`
 	Biography           string         `gorm:"column:Biography;type:varchar(1000);not null"`
 	Books               []Book         `gorm:"many2many:BookAuthors;"`
@@ -43,6 +45,10 @@ type CreateAuthorPayload struct {
 	Image       *multipart.FileHeader `json:"image" validate:"required"`
 }
 
+type MergeAuthorPayload struct {
+	TargetAuthorID uuid.UUID `json:"targetAuthorId" validate:"required"`
+}
+
 type AuthorBasicInfoResponse struct {
 	ID        string `json:"id"`
 	AvatarURL string `json:"avatarUrl"`
@@ -50,29 +56,58 @@ type AuthorBasicInfoResponse struct {
 }
 
 type AuthorDetailsResponse struct {
-	ID          string    `json:"id"`
-	FullName    string    `json:"fullName"`
-	Nationality string    `json:"nationality"`
-	Biography   string    `json:"biography"`
-	AvatarURL   string    `json:"avatarUrl"`
-	CreatedAt   time.Time `json:"createdAt"`
+	ID             string            `json:"id"`
+	FullName       string            `json:"fullName"`
+	Nationality    string            `json:"nationality"`
+	Biography      string            `json:"biography"`
+	AvatarURL      string            `json:"avatarUrl"`
+	AvatarVariants map[string]string `json:"avatarVariants,omitempty"`
+	CreatedAt      time.Time         `json:"createdAt"`
+}
+
+type AuthorProfileResponse struct {
+	ID                  string            `json:"id"`
+	FullName            string            `json:"fullName"`
+	Nationality         string            `json:"nationality"`
+	Biography           string            `json:"biography"`
+	AvatarURL           string            `json:"avatarUrl"`
+	AvatarVariants      map[string]string `json:"avatarVariants,omitempty"`
+	TotalPublishedBooks int64             `json:"totalPublishedBooks"`
+}
+
+func (a *Author) AvatarImageIDs() []uuid.UUID {
+	return collectImageIDs(a.AvatarImageClientID, a.AvatarVariants)
 }
 
 func (a *Author) ToAuthorBasicInfoResponse() *AuthorBasicInfoResponse {
 	return &AuthorBasicInfoResponse{
-		ID:       a.BaseModel.ID.String(),
-		FullName: a.FullName,
+		ID:        a.BaseModel.ID.String(),
+		AvatarURL: a.AvatarVariants.URL(SmallImageVariant, a.AvatarURL.String),
+		FullName:  a.FullName,
 	}
 }
 
 func (a *Author) ToAuthorDetailsResponse() *AuthorDetailsResponse {
 	return &AuthorDetailsResponse{
-		ID:          a.BaseModel.ID.String(),
-		FullName:    a.FullName,
-		Nationality: a.Nationality,
-		Biography:   a.Biography,
-		AvatarURL:   a.AvatarURL.String,
-		CreatedAt:   a.CreatedAt,
+		ID:             a.BaseModel.ID.String(),
+		FullName:       a.FullName,
+		Nationality:    a.Nationality,
+		Biography:      a.Biography,
+		AvatarURL:      a.AvatarVariants.URL(MediumImageVariant, a.AvatarURL.String),
+		AvatarVariants: a.AvatarVariants.URLs(),
+		CreatedAt:      a.CreatedAt,
+	}
+}
+
+func (a *Author) ToAuthorProfileResponse(totalPublishedBooks int64) *AuthorProfileResponse {
+	return &AuthorProfileResponse{
+		ID:                  a.BaseModel.ID.String(),
+		FullName:            a.FullName,
+		Nationality:         a.Nationality,
+		Biography:           a.Biography,
+		AvatarURL:           a.AvatarVariants.URL(LargeImageVariant, a.AvatarURL.String),
+		AvatarVariants:      a.AvatarVariants.URLs(),
+		TotalPublishedBooks: totalPublishedBooks,
 	}
 }
 
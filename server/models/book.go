@@ -2,6 +2,8 @@ package models
 
 import (
 	"errors"
+	"mime/multipart"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,12 +21,14 @@ var (
 
 type Book struct {
 	BaseModel
-	Title            string `gorm:"column:Title;type:varchar(500);not null"`
-	Description      string `gorm:"column:Description;type:varchar(2000);not null"`
-	TotalPages       uint   `gorm:"column:TotalPages;type:INT UNSIGNED;not null;default:0"`
-	TotalEvaluations uint   `gorm:"column:TotalEvaluations;type:INT UNSIGNED;not null;default:0"`
-	CoverImageURL    string `gorm:"column:CoverImageUrl;type:varchar(500);not null"`
-	Published        bool   `gorm:"column:Published;type:TINYINT;not null;default:0;index"`
+	Title              string        `gorm:"column:Title;type:varchar(500);not null"`
+	Description        string        `gorm:"column:Description;type:varchar(2000);not null"`
+	TotalPages         uint          `gorm:"column:TotalPages;type:INT UNSIGNED;not null;default:0"`
+	TotalEvaluations   uint          `gorm:"column:TotalEvaluations;type:INT UNSIGNED;not null;default:0"`
+	CoverImageURL      string        `gorm:"column:CoverImageUrl;type:varchar(500);not null"`
+	Published          bool          `gorm:"column:Published;type:TINYINT;not null;default:0;index"`
+	CoverImageClientID uuid.UUID     `gorm:"column:CoverImageClientId;type:char(36);index"`
+	CoverVariants      ImageVariants `gorm:"column:CoverVariants;type:json"`
 
 	Categories  []Category   `gorm:"many2many:BookCategories;"`
 	Authors     []Author     `gorm:"many2many:BookAuthors;"`
@@ -47,17 +51,28 @@ type PublishedBookPagination struct {
 	Pagination
 	Query      *string `json:"query"`
 	CategoryID *string `json:"categoryId"`
+	AuthorID   *string `json:"authorId"`
 }
 
 type CreateBookPayload struct {
 	Title         string      `json:"title" validate:"required,min=1,max=500"`
 	Description   string      `json:"description" validate:"required,min=1,max=2000"`
 	TotalPages    uint        `json:"totalPages" validate:"required,min=1"`
-	CoverImageURL string      `json:"coverImageURL" validate:"required,url,max=500"`
+	CoverImageURL string      `json:"coverImageURL" validate:"omitempty,url,max=500"`
 	AuthorsIds    []uuid.UUID `json:"authorsIds" validate:"required,min=1,dive,required"`
 	Categories    []string    `json:"categories" validate:"required,min=1,dive,required,min=1,max=255"`
 }
 
+type UpdateBookCoverPayload struct {
+	Image *multipart.FileHeader `json:"image" validate:"required"`
+}
+
+type BookCoverTask struct {
+	BookID    uuid.UUID `json:"bookId"`
+	Image     []byte    `json:"image,omitempty"`
+	SourceURL string    `json:"sourceUrl,omitempty"`
+}
+
 type BookSearchResponse struct {
 	ExternalBookID string   `json:"externalBookId"`
 	TotalPages     uint     `json:"totalPages"`
@@ -69,28 +84,60 @@ type BookSearchResponse struct {
 }
 
 type BookResponse struct {
-	ID               string    `json:"id"`
-	TotalPages       uint      `json:"totalPages"`
-	TotalEvaluations uint      `json:"totalEvaluations"`
-	Title            string    `json:"title"`
-	Description      string    `json:"description"`
-	CoverImageURL    string    `json:"coverImageURL"`
-	Published        bool      `json:"published"`
-	Authors          []string  `json:"authors"`
-	Categories       []string  `json:"categories"`
-	CreatedAt        time.Time `json:"createdAt"`
+	ID               string            `json:"id"`
+	TotalPages       uint              `json:"totalPages"`
+	TotalEvaluations uint              `json:"totalEvaluations"`
+	Title            string            `json:"title"`
+	Description      string            `json:"description"`
+	CoverImageURL    string            `json:"coverImageURL"`
+	CoverVariants    map[string]string `json:"coverVariants,omitempty"`
+	Published        bool              `json:"published"`
+	Authors          []string          `json:"authors"`
+	Categories       []string          `json:"categories"`
+	CreatedAt        time.Time         `json:"createdAt"`
+}
+
+type BookSummaryResponse struct {
+	ID            string   `json:"id"`
+	Title         string   `json:"title"`
+	CoverImageURL string   `json:"coverImageURL"`
+	Authors       []string `json:"authors"`
 }
 
 type PublishedBookResponse struct {
-	ID               string   `json:"id"`
-	TotalPages       uint     `json:"totalPages"`
-	TotalEvaluations uint     `json:"totalEvaluations"`
-	RateAverage      float32  `json:"rateAverage"`
-	Title            string   `json:"title"`
-	HasRead          bool     `json:"hasRead"`
-	CoverImageURL    string   `json:"coverImageURL"`
-	Authors          []string `json:"authors"`
-	Categories       []string `json:"categories"`
+	ID               string            `json:"id"`
+	TotalPages       uint              `json:"totalPages"`
+	TotalEvaluations uint              `json:"totalEvaluations"`
+	RateAverage      float32           `json:"rateAverage"`
+	Title            string            `json:"title"`
+	HasRead          bool              `json:"hasRead"`
+	CoverImageURL    string            `json:"coverImageURL"`
+	CoverVariants    map[string]string `json:"coverVariants,omitempty"`
+	Authors          []string          `json:"authors"`
+	Categories       []string          `json:"categories"`
+}
+
+type BookAuthorResponse struct {
+	ID             string            `json:"id"`
+	FullName       string            `json:"fullName"`
+	AvatarURL      string            `json:"avatarUrl"`
+	AvatarVariants map[string]string `json:"avatarVariants,omitempty"`
+}
+
+type PublishedBookDetailsResponse struct {
+	ID               string                       `json:"id"`
+	Title            string                       `json:"title"`
+	Description      string                       `json:"description"`
+	TotalPages       uint                         `json:"totalPages"`
+	TotalEvaluations uint                         `json:"totalEvaluations"`
+	RateAverage      float32                      `json:"rateAverage"`
+	RatingBreakdown  map[string]int64             `json:"ratingBreakdown"`
+	CoverImageURL    string                       `json:"coverImageURL"`
+	CoverVariants    map[string]string            `json:"coverVariants,omitempty"`
+	Authors          []BookAuthorResponse         `json:"authors"`
+	Categories       []CategoryResponse           `json:"categories"`
+	UserEvaluation   *EvaluationBasicInfoResponse `json:"userEvaluation,omitempty"`
+	Shelf            *BookShelfResponse           `json:"shelf,omitempty"`
 }
 
 func (cbp *CreateBookPayload) ToBook(authors []Author, categories []Category) *Book {
@@ -109,6 +156,10 @@ func (cbp *CreateBookPayload) ToBook(authors []Author, categories []Category) *B
 	}
 }
 
+func (b *Book) CoverImageIDs() []uuid.UUID {
+	return collectImageIDs(b.CoverImageClientID, b.CoverVariants)
+}
+
 func (b *Book) ToBookResponse() *BookResponse {
 	var authors []string
 	for _, author := range b.Authors {
@@ -126,13 +177,28 @@ func (b *Book) ToBookResponse() *BookResponse {
 		TotalEvaluations: b.TotalEvaluations,
 		Title:            b.Title,
 		Description:      b.Description,
-		CoverImageURL:    b.CoverImageURL,
+		CoverImageURL:    b.CoverVariants.URL(LargeImageVariant, b.CoverImageURL),
+		CoverVariants:    b.CoverVariants.URLs(),
 		Published:        b.Published,
 		CreatedAt:        b.CreatedAt,
 		Authors:          authors,
 		Categories:       categories,
 	}
 }
+func (b *Book) ToBookSummaryResponse() *BookSummaryResponse {
+	var authors []string
+	for _, author := range b.Authors {
+		authors = append(authors, author.FullName)
+	}
+
+	return &BookSummaryResponse{
+		ID:            b.ID.String(),
+		Title:         b.Title,
+		CoverImageURL: b.CoverVariants.URL(MediumImageVariant, b.CoverImageURL),
+		Authors:       authors,
+	}
+}
+
 func (b *Book) ToPublishedBookResponse(rateAverage float32, hasRead bool) *PublishedBookResponse {
 	var authors []string
 	for _, author := range b.Authors {
@@ -151,12 +217,71 @@ func (b *Book) ToPublishedBookResponse(rateAverage float32, hasRead bool) *Publi
 		RateAverage:      rateAverage,
 		Title:            b.Title,
 		HasRead:          hasRead,
-		CoverImageURL:    b.CoverImageURL,
+		CoverImageURL:    b.CoverVariants.URL(MediumImageVariant, b.CoverImageURL),
+		CoverVariants:    b.CoverVariants.URLs(),
 		Authors:          authors,
 		Categories:       categories,
 	}
 }
 
+func (b *Book) ToPublishedBookDetailsResponse(locale string, ratings []RatingCount, userEvaluation *Evaluation, shelf *BookShelf) *PublishedBookDetailsResponse {
+	authors := make([]BookAuthorResponse, 0, len(b.Authors))
+	for _, author := range b.Authors {
+		authors = append(authors, BookAuthorResponse{
+			ID:             author.ID.String(),
+			FullName:       author.FullName,
+			AvatarURL:      author.AvatarVariants.URL(SmallImageVariant, author.AvatarURL.String),
+			AvatarVariants: author.AvatarVariants.URLs(),
+		})
+	}
+
+	categories := make([]CategoryResponse, 0, len(b.Categories))
+	for _, category := range b.Categories {
+		categories = append(categories, *category.ToCategoryResponse(locale))
+	}
+
+	breakdown := make(map[string]int64, 5)
+	for rate := 1; rate <= 5; rate++ {
+		breakdown[strconv.Itoa(rate)] = 0
+	}
+
+	var sum, total int64
+	for _, rating := range ratings {
+		breakdown[strconv.Itoa(int(rating.Rate))] = rating.Total
+		sum += int64(rating.Rate) * rating.Total
+		total += rating.Total
+	}
+
+	var rateAverage float32
+	if total > 0 {
+		rateAverage = float32(sum) / float32(total)
+	}
+
+	response := &PublishedBookDetailsResponse{
+		ID:               b.ID.String(),
+		Title:            b.Title,
+		Description:      b.Description,
+		TotalPages:       b.TotalPages,
+		TotalEvaluations: b.TotalEvaluations,
+		RateAverage:      rateAverage,
+		RatingBreakdown:  breakdown,
+		CoverImageURL:    b.CoverVariants.URL(LargeImageVariant, b.CoverImageURL),
+		CoverVariants:    b.CoverVariants.URLs(),
+		Authors:          authors,
+		Categories:       categories,
+	}
+
+	if userEvaluation != nil {
+		response.UserEvaluation = userEvaluation.ToEvaluationBasicInfoResponse(userEvaluation.UserID)
+	}
+
+	if shelf != nil {
+		response.Shelf = shelf.ToBookShelfResponse()
+	}
+
+	return response
+}
+
 func NewBookPagination(page, limit, sort, title, bookID, authorID, categoryID string) (*BookPagination, error) {
 	pagination, err := NewPagination(page, limit, sort)
 	if err != nil {
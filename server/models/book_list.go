@@ -0,0 +1,175 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/G-Villarinho/book-wise-api/utils"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrBookListNotFound     = errors.New("book list not found")
+	ErrBookListNotOwned     = errors.New("the book list does not belong to the user")
+	ErrBookAlreadyInList    = errors.New("the book is already in the list")
+	ErrBookNotInList        = errors.New("the book is not in the list")
+	ErrInvalidBookListOrder = errors.New("the new order must contain every book of the list exactly once")
+)
+
+type BookListVisibility string
+
+const (
+	PrivateBookList  BookListVisibility = "private"
+	UnlistedBookList BookListVisibility = "unlisted"
+	PublicBookList   BookListVisibility = "public"
+)
+
+type BookList struct {
+	BaseModel
+	UserID      uuid.UUID          `gorm:"column:UserId;type:char(36);not null;index"`
+	Title       string             `gorm:"column:Title;type:varchar(120);not null"`
+	Description string             `gorm:"column:Description;type:varchar(1000)"`
+	Visibility  BookListVisibility `gorm:"column:Visibility;type:varchar(20);not null;default:'private';index"`
+	TotalBooks  uint               `gorm:"column:TotalBooks;type:INT UNSIGNED;not null;default:0"`
+	User        User               `gorm:"foreignKey:UserID;references:ID"`
+	Items       []BookListItem     `gorm:"foreignKey:BookListID;references:ID"`
+}
+
+func (bl *BookList) TableName() string {
+	return "BookLists"
+}
+
+type BookListItem struct {
+	BaseModel
+	BookListID uuid.UUID `gorm:"column:BookListId;type:char(36);not null;uniqueIndex:idx_book_list_items_list_book"`
+	BookID     uuid.UUID `gorm:"column:BookId;type:char(36);not null;uniqueIndex:idx_book_list_items_list_book;index"`
+	Position   uint      `gorm:"column:Position;type:INT UNSIGNED;not null"`
+	Note       string    `gorm:"column:Note;type:varchar(500)"`
+	Book       Book      `gorm:"foreignKey:BookID;references:ID"`
+}
+
+func (bli *BookListItem) TableName() string {
+	return "BookListItems"
+}
+
+type BookListPagination struct {
+	Pagination
+	Query  *string    `json:"query"`
+	BookID *uuid.UUID `json:"bookId"`
+}
+
+type CreateBookListPayload struct {
+	Title       string             `json:"title" validate:"required,min=3,max=120"`
+	Description string             `json:"description" validate:"max=1000"`
+	Visibility  BookListVisibility `json:"visibility" validate:"required,oneof=private unlisted public"`
+}
+
+type UpdateBookListPayload struct {
+	Title       string             `json:"title" validate:"required,min=3,max=120"`
+	Description string             `json:"description" validate:"max=1000"`
+	Visibility  BookListVisibility `json:"visibility" validate:"required,oneof=private unlisted public"`
+}
+
+type AddBookListItemPayload struct {
+	BookID uuid.UUID `json:"bookId" validate:"required"`
+	Note   string    `json:"note" validate:"max=500"`
+}
+
+type UpdateBookListItemPayload struct {
+	Note string `json:"note" validate:"max=500"`
+}
+
+type ReorderBookListPayload struct {
+	BookIDs []uuid.UUID `json:"bookIds" validate:"required,min=1,dive,required"`
+}
+
+type BookListResponse struct {
+	ID           string             `json:"id"`
+	Title        string             `json:"title"`
+	Description  string             `json:"description"`
+	Visibility   BookListVisibility `json:"visibility"`
+	TotalBooks   uint               `json:"totalBooks"`
+	Owner        PublicUserResponse `json:"owner"`
+	ContainsBook *bool              `json:"containsBook,omitempty"`
+	CreatedAt    time.Time          `json:"createdAt"`
+}
+
+type BookListItemResponse struct {
+	Position uint                   `json:"position"`
+	Note     string                 `json:"note"`
+	Book     *PublishedBookResponse `json:"book"`
+}
+
+type BookListDetailsResponse struct {
+	BookListResponse
+	Items []BookListItemResponse `json:"items"`
+}
+
+func (cblp *CreateBookListPayload) ToBookList(userID uuid.UUID) *BookList {
+	ID, _ := uuid.NewV7()
+
+	return &BookList{
+		BaseModel: BaseModel{
+			ID:        ID,
+			CreatedAt: time.Now().UTC(),
+		},
+		UserID:      userID,
+		Title:       cblp.Title,
+		Description: cblp.Description,
+		Visibility:  cblp.Visibility,
+	}
+}
+
+func (abip *AddBookListItemPayload) ToBookListItem(bookListID uuid.UUID) *BookListItem {
+	ID, _ := uuid.NewV7()
+
+	return &BookListItem{
+		BaseModel: BaseModel{
+			ID:        ID,
+			CreatedAt: time.Now().UTC(),
+		},
+		BookListID: bookListID,
+		BookID:     abip.BookID,
+		Note:       abip.Note,
+	}
+}
+
+// CanBeViewedBy reports whether the list is reachable by the user. Unlisted
+// lists are reachable by anyone holding the link but never browsable.
+func (bl *BookList) CanBeViewedBy(userID uuid.UUID) bool {
+	return bl.Visibility != PrivateBookList || bl.UserID == userID
+}
+
+func (bl *BookList) ToBookListResponse(viewerID uuid.UUID) *BookListResponse {
+	return &BookListResponse{
+		ID:          bl.ID.String(),
+		Title:       bl.Title,
+		Description: bl.Description,
+		Visibility:  bl.Visibility,
+		TotalBooks:  bl.TotalBooks,
+		Owner:       *bl.User.ToPublicUserResponse(viewerID),
+		CreatedAt:   bl.CreatedAt,
+	}
+}
+
+func NewBookListPagination(page, limit, query, bookID string) (*BookListPagination, error) {
+	pagination, err := NewPagination(page, limit, "CreatedAt DESC")
+	if err != nil {
+		return nil, err
+	}
+
+	bookListPagination := &BookListPagination{
+		Pagination: *pagination,
+		Query:      utils.GetQueryStringPointer(query),
+	}
+
+	if bookID != "" {
+		ID, err := uuid.Parse(bookID)
+		if err != nil {
+			return nil, err
+		}
+		bookListPagination.BookID = &ID
+	}
+
+	return bookListPagination, nil
+}
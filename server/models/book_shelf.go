@@ -0,0 +1,95 @@
+package models
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrBookShelfNotFound = errors.New("book is not on the user's shelf")
+)
+
+type BookShelfStatus string
+
+const (
+	WantToReadShelf BookShelfStatus = "want_to_read"
+	ReadingShelf    BookShelfStatus = "reading"
+	ReadShelf       BookShelfStatus = "read"
+)
+
+type BookShelf struct {
+	BaseModel
+	UserID     uuid.UUID       `gorm:"column:UserId;type:char(36);not null;uniqueIndex:idx_book_shelves_user_book"`
+	BookID     uuid.UUID       `gorm:"column:BookId;type:char(36);not null;uniqueIndex:idx_book_shelves_user_book;index"`
+	Status     BookShelfStatus `gorm:"column:Status;type:varchar(20);not null;index"`
+	StartedAt  sql.NullTime    `gorm:"column:StartedAt;null;default:null"`
+	FinishedAt sql.NullTime    `gorm:"column:FinishedAt;null;default:null"`
+	User       User            `gorm:"foreignKey:UserID;references:ID"`
+	Book       Book            `gorm:"foreignKey:BookID;references:ID"`
+}
+
+func (bs *BookShelf) TableName() string {
+	return "BookShelves"
+}
+
+type UpdateBookShelfPayload struct {
+	Status BookShelfStatus `json:"status" validate:"required,oneof=want_to_read reading read"`
+}
+
+type BookShelfResponse struct {
+	Status     BookShelfStatus `json:"status"`
+	StartedAt  *time.Time      `json:"startedAt,omitempty"`
+	FinishedAt *time.Time      `json:"finishedAt,omitempty"`
+}
+
+func NewBookShelf(userID, bookID uuid.UUID, now time.Time) *BookShelf {
+	ID, _ := uuid.NewV7()
+
+	return &BookShelf{
+		BaseModel: BaseModel{
+			ID:        ID,
+			CreatedAt: now,
+		},
+		UserID: userID,
+		BookID: bookID,
+	}
+}
+
+func (bs *BookShelf) ApplyStatus(status BookShelfStatus, now time.Time) {
+	bs.Status = status
+
+	switch status {
+	case WantToReadShelf:
+		bs.StartedAt = sql.NullTime{}
+		bs.FinishedAt = sql.NullTime{}
+	case ReadingShelf:
+		if !bs.StartedAt.Valid {
+			bs.StartedAt = sql.NullTime{Time: now, Valid: true}
+		}
+		bs.FinishedAt = sql.NullTime{}
+	case ReadShelf:
+		if !bs.StartedAt.Valid {
+			bs.StartedAt = sql.NullTime{Time: now, Valid: true}
+		}
+		bs.FinishedAt = sql.NullTime{Time: now, Valid: true}
+	}
+}
+
+func (bs *BookShelf) ToBookShelfResponse() *BookShelfResponse {
+	response := &BookShelfResponse{
+		Status: bs.Status,
+	}
+
+	if bs.StartedAt.Valid {
+		response.StartedAt = &bs.StartedAt.Time
+	}
+
+	if bs.FinishedAt.Valid {
+		response.FinishedAt = &bs.FinishedAt.Time
+	}
+
+	return response
+}
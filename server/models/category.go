@@ -1,30 +1,217 @@
 package models
 
-import "errors"
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/G-Villarinho/book-wise-api/utils"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+)
+
+const (
+	CategoryPathSeparator     = "/"
+	CategorySlugIndex         = "idx_categories_unique_slug"
+	DefaultTopCategoriesLimit = 7
+	MaxTopCategoriesLimit     = 50
+)
 
 var (
-	ErrCategoriesNotFound = errors.New("categories not found in database")
+	ErrCategoriesNotFound    = errors.New("categories not found in database")
+	ErrCategoryNotFound      = errors.New("category not found in database")
+	ErrCategoryAlreadyExists = errors.New("a category with this name already exists")
+	ErrCategoryInUse         = errors.New("category is still linked to books")
+	ErrCategoryHasChildren   = errors.New("category still has subcategories")
+	ErrMergeSameCategory     = errors.New("cannot merge a category into itself")
+	ErrInvalidCategoryParent = errors.New("category cannot be moved under itself or one of its subcategories")
+	ErrCategorySlugTaken     = errors.New("category slug already taken by another category")
 )
 
 type Category struct {
 	BaseModel
-	Name           string `gorm:"column:Name;type:varchar(255);not null"`
-	NormalizedName string `gorm:"column:NormalizedName;type:varchar(255);not null;unique"`
-	Books          []Book `gorm:"many2many:BookCategories;"`
+	ParentID       *uuid.UUID           `gorm:"column:ParentId;type:char(36);null;default:null;index"`
+	Name           string               `gorm:"column:Name;type:varchar(255);not null"`
+	NormalizedName string               `gorm:"column:NormalizedName;type:varchar(700);not null;unique"`
+	Slug           string               `gorm:"column:Slug;type:varchar(700);not null;default:'';uniqueIndex:idx_categories_unique_slug"`
+	Translations   CategoryTranslations `gorm:"column:Translations;type:json"`
+	Books          []Book               `gorm:"many2many:BookCategories;"`
 }
 
 func (c *Category) TableName() string {
 	return "Categories"
 }
 
+type CategoryAlias struct {
+	NormalizedName string    `gorm:"column:NormalizedName;type:varchar(700);primaryKey"`
+	CategoryID     uuid.UUID `gorm:"column:CategoryId;type:char(36);not null;index"`
+	Category       Category  `gorm:"foreignKey:CategoryID;references:ID"`
+}
+
+func (ca *CategoryAlias) TableName() string {
+	return "CategoryAliases"
+}
+
+type CategoryTranslations map[string]string
+
+func (ct CategoryTranslations) Value() (driver.Value, error) {
+	if len(ct) == 0 {
+		return nil, nil
+	}
+
+	return jsoniter.MarshalToString(ct)
+}
+
+func (ct *CategoryTranslations) Scan(value any) error {
+	if value == nil {
+		*ct = nil
+		return nil
+	}
+
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("scan category translations: unsupported type %T", value)
+	}
+
+	return jsoniter.Unmarshal(data, ct)
+}
+
+type CreateCategoryPayload struct {
+	Name     string            `json:"name" validate:"required,min=1,max=255,excludes=/"`
+	ParentID *uuid.UUID        `json:"parentId"`
+	Names    map[string]string `json:"names" validate:"omitempty,dive,keys,oneof=pt-BR en,endkeys,required,max=255"`
+}
+
+// UpdateCategoryPayload keeps the current parent unless ParentID is sent to
+// move the category under another one, or MoveToRoot to make it top-level.
+type UpdateCategoryPayload struct {
+	Name       string            `json:"name" validate:"required,min=1,max=255,excludes=/"`
+	ParentID   *uuid.UUID        `json:"parentId"`
+	MoveToRoot bool              `json:"moveToRoot" validate:"excluded_with=ParentID"`
+	Names      map[string]string `json:"names" validate:"omitempty,dive,keys,oneof=pt-BR en,endkeys,required,max=255"`
+}
+
+type MergeCategoryPayload struct {
+	TargetCategoryID uuid.UUID `json:"targetCategoryId" validate:"required"`
+}
+
 type CategoryResponse struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID       string            `json:"id"`
+	ParentID *string           `json:"parentId,omitempty"`
+	Name     string            `json:"name"`
+	Names    map[string]string `json:"names,omitempty"`
+	Slug     string            `json:"slug"`
+}
+
+type TopCategory struct {
+	ID               uuid.UUID            `json:"id"`
+	ParentID         *uuid.UUID           `json:"parentId,omitempty"`
+	Name             string               `json:"name"`
+	Slug             string               `json:"slug"`
+	Translations     CategoryTranslations `json:"translations,omitempty"`
+	TotalEvaluations int64                `json:"totalEvaluations"`
+	TotalBooks       int64                `json:"totalBooks"`
+}
+
+type CategoryActivity struct {
+	CategoryID       uuid.UUID `gorm:"column:CategoryId"`
+	TotalEvaluations int64     `gorm:"column:TotalEvaluations"`
+	TotalBooks       int64     `gorm:"column:TotalBooks"`
+}
+
+type TopCategoryResponse struct {
+	CategoryResponse
+	TotalEvaluations int64 `json:"totalEvaluations"`
+	TotalBooks       int64 `json:"totalBooks"`
+}
+
+func (c *Category) DisplayName(locale string) string {
+	if name, ok := c.Translations[locale]; ok && name != "" {
+		return name
+	}
+
+	return c.Name
+}
+
+func (c *Category) ToCategoryResponse(locale string) *CategoryResponse {
+	response := &CategoryResponse{
+		ID:    c.BaseModel.ID.String(),
+		Name:  c.DisplayName(locale),
+		Names: c.Translations,
+		Slug:  c.Slug,
+	}
+
+	if c.ParentID != nil {
+		parentID := c.ParentID.String()
+		response.ParentID = &parentID
+	}
+
+	return response
+}
+
+func SplitCategoryPath(path string) []string {
+	var segments []string
+	for _, segment := range strings.Split(path, CategoryPathSeparator) {
+		segment = strings.TrimSpace(segment)
+		if utils.NormalizeString(segment) != "" {
+			segments = append(segments, segment)
+		}
+	}
+
+	return segments
 }
 
-func (c *Category) ToCategoryResponse() *CategoryResponse {
-	return &CategoryResponse{
-		ID:   c.BaseModel.ID.String(),
-		Name: c.Name,
+func NormalizeCategoryPath(segments []string) string {
+	normalized := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		normalized = append(normalized, utils.NormalizeString(segment))
 	}
+
+	return strings.Join(normalized, CategoryPathSeparator)
+}
+
+func ChildCategoryNormalizedName(parentNormalizedName, name string) string {
+	if parentNormalizedName == "" {
+		return utils.NormalizeString(name)
+	}
+
+	return parentNormalizedName + CategoryPathSeparator + utils.NormalizeString(name)
+}
+
+func (c *Category) ToTopCategory(activity CategoryActivity) TopCategory {
+	return TopCategory{
+		ID:               c.ID,
+		ParentID:         c.ParentID,
+		Name:             c.Name,
+		Slug:             c.Slug,
+		Translations:     c.Translations,
+		TotalEvaluations: activity.TotalEvaluations,
+		TotalBooks:       activity.TotalBooks,
+	}
+}
+
+func (tc *TopCategory) ToTopCategoryResponse(locale string) *TopCategoryResponse {
+	category := Category{
+		BaseModel:    BaseModel{ID: tc.ID},
+		ParentID:     tc.ParentID,
+		Name:         tc.Name,
+		Slug:         tc.Slug,
+		Translations: tc.Translations,
+	}
+
+	return &TopCategoryResponse{
+		CategoryResponse: *category.ToCategoryResponse(locale),
+		TotalEvaluations: tc.TotalEvaluations,
+		TotalBooks:       tc.TotalBooks,
+	}
+}
+
+func ParseTopCategoriesLimit(value string) (int, error) {
+	return ParseLimit(value, DefaultTopCategoriesLimit, MaxTopCategoriesLimit)
 }
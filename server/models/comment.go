@@ -0,0 +1,97 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCommentNotFound      = errors.New("comment not found in database")
+	ErrInvalidCommentParent = errors.New("comments can only reply to top-level comments of the same evaluation")
+	ErrCommentNotOwned      = errors.New("the comment does not belong to the user")
+)
+
+type Comment struct {
+	BaseModel
+	EvaluationID uuid.UUID  `gorm:"column:EvaluationId;type:char(36);not null;index"`
+	UserID       uuid.UUID  `gorm:"column:UserId;type:char(36);not null;index"`
+	ParentID     *uuid.UUID `gorm:"column:ParentId;type:char(36);null;default:null;index"`
+	Content      string     `gorm:"column:Content;type:varchar(1000);not null"`
+	User         User       `gorm:"foreignKey:UserID;references:ID"`
+	Evaluation   Evaluation `gorm:"foreignKey:EvaluationID;references:ID"`
+	Replies      []Comment  `gorm:"foreignKey:ParentID;references:ID"`
+}
+
+func (c *Comment) TableName() string {
+	return "Comments"
+}
+
+type CreateCommentPayload struct {
+	Content  string     `json:"content" validate:"required,min=1,max=1000"`
+	ParentID *uuid.UUID `json:"parentId"`
+}
+
+type UpdateCommentPayload struct {
+	Content string `json:"content" validate:"required,min=1,max=1000"`
+}
+
+type CommentResponse struct {
+	ID            string            `json:"id"`
+	EvaluationID  string            `json:"evaluationId"`
+	ParentID      *string           `json:"parentId,omitempty"`
+	UserID        string            `json:"userId,omitempty"`
+	UserFullName  string            `json:"userFullName"`
+	UserAvatarURL string            `json:"userAvatarUrl,omitempty"`
+	UserHandle    string            `json:"userHandle,omitempty"`
+	Content       string            `json:"content"`
+	Edited        bool              `json:"edited"`
+	CreatedAt     time.Time         `json:"createdAt"`
+	Replies       []CommentResponse `json:"replies,omitempty"`
+}
+
+func (ccp *CreateCommentPayload) ToComment(evaluationID, userID uuid.UUID) *Comment {
+	ID, _ := uuid.NewV7()
+
+	return &Comment{
+		BaseModel: BaseModel{
+			ID:        ID,
+			CreatedAt: time.Now().UTC(),
+		},
+		EvaluationID: evaluationID,
+		UserID:       userID,
+		ParentID:     ccp.ParentID,
+		Content:      ccp.Content,
+	}
+}
+
+func (c *Comment) ToCommentResponse(viewerID uuid.UUID) *CommentResponse {
+	author := c.User.ToPublicUserResponse(viewerID)
+	response := &CommentResponse{
+		ID:            c.ID.String(),
+		EvaluationID:  c.EvaluationID.String(),
+		UserID:        author.ID,
+		UserFullName:  author.FullName,
+		UserAvatarURL: author.AvatarURL,
+		UserHandle:    author.Handle,
+		Content:       c.Content,
+		Edited:        c.UpdatedAt.Valid,
+		CreatedAt:     c.CreatedAt,
+	}
+
+	if c.ParentID != nil {
+		parentID := c.ParentID.String()
+		response.ParentID = &parentID
+	}
+
+	for _, reply := range c.Replies {
+		response.Replies = append(response.Replies, *reply.ToCommentResponse(viewerID))
+	}
+
+	return response
+}
+
+func NewCommentPagination(page, limit string) (*Pagination, error) {
+	return NewPagination(page, limit, "CreatedAt ASC")
+}
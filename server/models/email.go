@@ -3,7 +3,9 @@ package models
 type EmailTemplate string
 
 const (
-	SignInMagicLink EmailTemplate = "sign-in-magic-link"
+	SignInMagicLink         EmailTemplate = "sign-in-magic-link"
+	NewBooksDigest          EmailTemplate = "new-books-digest"
+	ReadingChallengeSummary EmailTemplate = "reading-challenge-summary"
 )
 
 type Email struct {
@@ -8,35 +8,85 @@ import (
 )
 
 var (
-	ErrUserAlreadyEvaluteBook = errors.New("the user has already evaluated this book")
+	ErrUserAlreadyEvaluteBook   = errors.New("the user has already evaluated this book")
+	ErrEvaluationNotFound       = errors.New("evaluation not found in database")
+	ErrCannotLikeOwnEvaluation  = errors.New("the user cannot like their own evaluation")
+	ErrInvalidEvaluationSorting = errors.New("invalid evaluation sort parameter")
+)
+
+type EvaluationStatus string
+
+const (
+	VisibleEvaluation EvaluationStatus = "visible"
+	HiddenEvaluation  EvaluationStatus = "hidden"
+	PendingEvaluation EvaluationStatus = "pending"
+)
+
+const (
+	RecentEvaluationSort  = "recent"
+	HelpfulEvaluationSort = "helpful"
 )
 
 type Evaluation struct {
 	BaseModel
-	Rate        uint8     `gorm:"column:Rate;type:TINYINT;not null;default:0"`
-	Description string    `gorm:"column:Description;type:varchar(500);not null"`
-	UserID      uuid.UUID `gorm:"column:UserId;type:char(36);not null"`
-	BookID      uuid.UUID `gorm:"column:BookId;type:char(36);not null"`
-	User        User      `gorm:"foreignKey:UserID;references:ID"`
-	Book        Book      `gorm:"foreignKey:BookID;references:ID"`
+	Rate        uint8              `gorm:"column:Rate;type:TINYINT;not null;default:0"`
+	Description string             `gorm:"column:Description;type:varchar(500);not null"`
+	UserID      uuid.UUID          `gorm:"column:UserId;type:char(36);not null"`
+	BookID      uuid.UUID          `gorm:"column:BookId;type:char(36);not null"`
+	Likes       uint               `gorm:"column:Likes;type:INT UNSIGNED;not null;default:0"`
+	HasSpoilers bool               `gorm:"column:HasSpoilers;not null;default:false"`
+	Status      EvaluationStatus   `gorm:"column:Status;type:varchar(20);not null;default:'visible';index"`
+	FilterFlags string             `gorm:"column:FilterFlags;type:varchar(255)"`
+	User        User               `gorm:"foreignKey:UserID;references:ID"`
+	Book        Book               `gorm:"foreignKey:BookID;references:ID"`
+	Reports     []EvaluationReport `gorm:"foreignKey:EvaluationID;references:ID"`
 }
 
 func (e *Evaluation) TableName() string {
 	return "Evaluations"
 }
 
+type EvaluationLike struct {
+	BaseModel
+	EvaluationID uuid.UUID  `gorm:"column:EvaluationId;type:char(36);not null;uniqueIndex:idx_evaluation_likes_evaluation_user"`
+	UserID       uuid.UUID  `gorm:"column:UserId;type:char(36);not null;uniqueIndex:idx_evaluation_likes_evaluation_user;index"`
+	Evaluation   Evaluation `gorm:"foreignKey:EvaluationID;references:ID"`
+	User         User       `gorm:"foreignKey:UserID;references:ID"`
+}
+
+func (el *EvaluationLike) TableName() string {
+	return "EvaluationLikes"
+}
+
+type RatingCount struct {
+	Rate  uint8 `gorm:"column:Rate"`
+	Total int64 `gorm:"column:Total"`
+}
+
 type CreateEvaluationPayload struct {
 	Rate        uint8  `json:"rate" validate:"required,gte=1,lte=5"`
 	Description string `json:"description" validate:"required,max=500"`
+	HasSpoilers bool   `json:"hasSpoilers"`
 }
 
 type EvaluationBasicInfoResponse struct {
-	ID            string    `json:"id"`
-	UserFullName  string    `json:"userFullName"`
-	UserAvatarURL string    `json:"userAvatarUrl,omitempty"`
-	Rate          uint8     `json:"rate"`
-	Description   string    `json:"description"`
-	CreatedAt     time.Time `json:"createdAt"`
+	ID              string           `json:"id"`
+	UserFullName    string           `json:"userFullName"`
+	UserAvatarURL   string           `json:"userAvatarUrl,omitempty"`
+	UserHandle      string           `json:"userHandle,omitempty"`
+	Rate            uint8            `json:"rate"`
+	Description     string           `json:"description"`
+	DescriptionHTML string           `json:"descriptionHtml"`
+	HasSpoilers     bool             `json:"hasSpoilers"`
+	Likes           uint             `json:"likes"`
+	LikedByMe       bool             `json:"likedByMe"`
+	Status          EvaluationStatus `json:"status"`
+	CreatedAt       time.Time        `json:"createdAt"`
+}
+
+type EvaluationLikeResponse struct {
+	Likes     uint `json:"likes"`
+	LikedByMe bool `json:"likedByMe"`
 }
 
 func (cep *CreateEvaluationPayload) ToEvaluation(userID, bookID uuid.UUID) *Evaluation {
@@ -49,18 +99,73 @@ func (cep *CreateEvaluationPayload) ToEvaluation(userID, bookID uuid.UUID) *Eval
 		},
 		Rate:        cep.Rate,
 		Description: cep.Description,
+		HasSpoilers: cep.HasSpoilers || HasSpoilerSegments(ParseDescription(cep.Description)),
 		UserID:      userID,
 		BookID:      bookID,
+		Status:      VisibleEvaluation,
 	}
 }
 
-func (e *Evaluation) ToEvaluationBasicInfoResponse() *EvaluationBasicInfoResponse {
-	return &EvaluationBasicInfoResponse{
-		ID:            e.ID.String(),
-		UserFullName:  e.User.FullName,
-		UserAvatarURL: e.User.Avatar.String,
-		Rate:          e.Rate,
-		Description:   e.Description,
-		CreatedAt:     e.CreatedAt,
+// ToEvaluationBasicInfoResponse keeps the review visible to everyone, since it
+// counts towards the book's rating, but drops the author's identity when their
+// privacy settings hide their reviews from the viewer.
+func (e *Evaluation) ToEvaluationBasicInfoResponse(viewerID uuid.UUID) *EvaluationBasicInfoResponse {
+	segments := ParseDescription(e.Description)
+
+	response := &EvaluationBasicInfoResponse{
+		ID:              e.ID.String(),
+		UserFullName:    AnonymousUserName,
+		Rate:            e.Rate,
+		Description:     RenderDescriptionText(segments),
+		DescriptionHTML: RenderDescriptionHTML(segments),
+		HasSpoilers:     e.HasSpoilers,
+		Likes:           e.Likes,
+		Status:          e.Status,
+		CreatedAt:       e.CreatedAt,
+	}
+
+	if e.User.AreReviewsVisibleTo(viewerID) {
+		response.UserFullName = e.User.FullName
+		response.UserAvatarURL = e.User.AvatarVariants.URL(SmallImageVariant, e.User.Avatar.String)
+		response.UserHandle = e.User.Handle.String
 	}
+
+	return response
+}
+
+// IsVisibleTo reports whether the viewer may interact with the evaluation.
+// Hidden and pending evaluations are only reachable by their author.
+func (e *Evaluation) IsVisibleTo(viewerID uuid.UUID) bool {
+	return e.Status == VisibleEvaluation || e.UserID == viewerID
+}
+
+func NewEvaluationLike(evaluationID, userID uuid.UUID) *EvaluationLike {
+	ID, _ := uuid.NewV7()
+
+	return &EvaluationLike{
+		BaseModel: BaseModel{
+			ID:        ID,
+			CreatedAt: time.Now().UTC(),
+		},
+		EvaluationID: evaluationID,
+		UserID:       userID,
+	}
+}
+
+func NewEvaluationPagination(page, limit, sort string) (*Pagination, error) {
+	pagination, err := NewPagination(page, limit, "")
+	if err != nil {
+		return nil, err
+	}
+
+	switch sort {
+	case "", RecentEvaluationSort:
+		pagination.Sort = "CreatedAt DESC"
+	case HelpfulEvaluationSort:
+		pagination.Sort = "Likes DESC, CreatedAt DESC"
+	default:
+		return nil, ErrInvalidEvaluationSorting
+	}
+
+	return pagination, nil
 }
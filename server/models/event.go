@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+)
+
+type EventType string
+
+const (
+	AuthorAvatarUploadedEvent EventType = "author.avatar_uploaded"
+	BookPublishedEvent        EventType = "book.published"
+	EvaluationCreatedEvent    EventType = "evaluation.created"
+	NotificationCreatedEvent  EventType = "notification.created"
+)
+
+type Event struct {
+	ID        string              `json:"id"`
+	Type      EventType           `json:"type"`
+	Data      jsoniter.RawMessage `json:"data"`
+	CreatedAt time.Time           `json:"createdAt"`
+}
+
+func NewEvent(eventType EventType, data any) (*Event, error) {
+	payload, err := jsoniter.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	ID, _ := uuid.NewV7()
+
+	return &Event{
+		ID:        ID.String(),
+		Type:      eventType,
+		Data:      payload,
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
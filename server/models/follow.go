@@ -0,0 +1,59 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrFollowNotFound       = errors.New("user does not follow the target")
+	ErrCannotFollowYourself = errors.New("user cannot follow themselves")
+)
+
+type FollowTargetType string
+
+const (
+	AuthorFollowTarget   FollowTargetType = "author"
+	CategoryFollowTarget FollowTargetType = "category"
+	UserFollowTarget     FollowTargetType = "user"
+)
+
+type Follow struct {
+	BaseModel
+	UserID        uuid.UUID        `gorm:"column:UserId;type:char(36);not null;uniqueIndex:idx_follows_user_target"`
+	TargetType    FollowTargetType `gorm:"column:TargetType;type:varchar(20);not null;uniqueIndex:idx_follows_user_target;index:idx_follows_target"`
+	TargetID      uuid.UUID        `gorm:"column:TargetId;type:char(36);not null;uniqueIndex:idx_follows_user_target;index:idx_follows_target"`
+	NotifyByEmail bool             `gorm:"column:NotifyByEmail;type:TINYINT;not null;default:0"`
+	User          User             `gorm:"foreignKey:UserID;references:ID"`
+}
+
+func (f *Follow) TableName() string {
+	return "Follows"
+}
+
+type BookFollower struct {
+	UserID        uuid.UUID `gorm:"column:UserId"`
+	NotifyByEmail bool      `gorm:"column:NotifyByEmail"`
+}
+
+type FollowPayload struct {
+	NotifyByEmail bool `json:"notifyByEmail"`
+}
+
+type FollowResponse struct {
+	TargetType    FollowTargetType `json:"targetType"`
+	TargetID      string           `json:"targetId"`
+	NotifyByEmail bool             `json:"notifyByEmail"`
+	CreatedAt     time.Time        `json:"createdAt"`
+}
+
+func (f *Follow) ToFollowResponse() *FollowResponse {
+	return &FollowResponse{
+		TargetType:    f.TargetType,
+		TargetID:      f.TargetID.String(),
+		NotifyByEmail: f.NotifyByEmail,
+		CreatedAt:     f.CreatedAt,
+	}
+}
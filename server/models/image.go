@@ -1,9 +1,51 @@
 package models
 
 import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+
 	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+)
+
+type ImageKind string
+
+const (
+	AvatarImage ImageKind = "avatar"
+	CoverImage  ImageKind = "cover"
+)
+
+const (
+	SmallImageVariant  = "small"
+	MediumImageVariant = "medium"
+	LargeImageVariant  = "large"
 )
 
+type ImageVariantSpec struct {
+	Name   string
+	Width  int
+	Height int
+	Square bool
+}
+
+var imageVariantSpecs = map[ImageKind][]ImageVariantSpec{
+	AvatarImage: {
+		{Name: SmallImageVariant, Width: 64, Height: 64, Square: true},
+		{Name: MediumImageVariant, Width: 128, Height: 128, Square: true},
+		{Name: LargeImageVariant, Width: 512, Height: 512, Square: true},
+	},
+	CoverImage: {
+		{Name: SmallImageVariant, Width: 128},
+		{Name: MediumImageVariant, Width: 320},
+		{Name: LargeImageVariant, Width: 640},
+	},
+}
+
+func GetImageVariantSpecs(kind ImageKind) []ImageVariantSpec {
+	return imageVariantSpecs[kind]
+}
+
 type ImageUploadTask struct {
 	RecordID uuid.UUID `json:"recordId"`
 	Image    []byte    `json:"image"`
@@ -13,3 +55,91 @@ type UploadImageResponse struct {
 	ID  uuid.UUID
 	URL string
 }
+
+type ImageVariant struct {
+	ID  uuid.UUID `json:"id"`
+	URL string    `json:"url"`
+}
+
+type ImageVariants map[string]ImageVariant
+
+func (iv ImageVariants) Value() (driver.Value, error) {
+	if len(iv) == 0 {
+		return nil, nil
+	}
+
+	return jsoniter.MarshalToString(iv)
+}
+
+func (iv *ImageVariants) Scan(value any) error {
+	if value == nil {
+		*iv = nil
+		return nil
+	}
+
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("scan image variants: unsupported type %T", value)
+	}
+
+	return jsoniter.Unmarshal(data, iv)
+}
+
+func (iv ImageVariants) URL(name, fallback string) string {
+	if variant, ok := iv[name]; ok && variant.URL != "" {
+		return variant.URL
+	}
+
+	return fallback
+}
+
+func (iv ImageVariants) URLs() map[string]string {
+	if len(iv) == 0 {
+		return nil
+	}
+
+	urls := make(map[string]string, len(iv))
+	for name, variant := range iv {
+		urls[name] = variant.URL
+	}
+
+	return urls
+}
+
+func (iv ImageVariants) IDs() []uuid.UUID {
+	var IDs []uuid.UUID
+	for _, variant := range iv {
+		IDs = append(IDs, variant.ID)
+	}
+
+	return IDs
+}
+
+func collectImageIDs(imageClientID uuid.UUID, variants ImageVariants) []uuid.UUID {
+	IDs := variants.IDs()
+	if imageClientID == uuid.Nil {
+		return IDs
+	}
+
+	for _, ID := range IDs {
+		if ID == imageClientID {
+			return IDs
+		}
+	}
+
+	return append(IDs, imageClientID)
+}
+
+type ImageDeleteTask struct {
+	ImageIDs []uuid.UUID `json:"imageIds"`
+}
+
+type StoredImage struct {
+	ID         uuid.UUID
+	UploadedAt time.Time
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"context"
+	"strings"
+
+	"github.com/G-Villarinho/book-wise-api/internal"
+)
+
+const (
+	PortugueseLocale = "pt-BR"
+	EnglishLocale    = "en"
+	DefaultLocale    = PortugueseLocale
+)
+
+var supportedLocales = []string{PortugueseLocale, EnglishLocale}
+
+func ResolveLocale(acceptLanguage string) string {
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag := strings.TrimSpace(strings.SplitN(part, ";", 2)[0])
+		if tag == "" {
+			continue
+		}
+
+		for _, locale := range supportedLocales {
+			if strings.EqualFold(tag, locale) {
+				return locale
+			}
+		}
+
+		language := strings.SplitN(tag, "-", 2)[0]
+		for _, locale := range supportedLocales {
+			if strings.EqualFold(language, strings.SplitN(locale, "-", 2)[0]) {
+				return locale
+			}
+		}
+	}
+
+	return DefaultLocale
+}
+
+func GetLocaleFromContext(ctx context.Context) string {
+	locale, ok := ctx.Value(internal.LocaleKey).(string)
+	if !ok || locale == "" {
+		return DefaultLocale
+	}
+
+	return locale
+}
@@ -0,0 +1,164 @@
+package models
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrEvaluationAlreadyReported = errors.New("the user has already reported this evaluation")
+	ErrCannotReportOwnEvaluation = errors.New("the user cannot report their own evaluation")
+)
+
+type ReportReason string
+
+const (
+	SpamReport      ReportReason = "spam"
+	OffensiveReport ReportReason = "offensive"
+	SpoilerReport   ReportReason = "spoiler"
+	OtherReport     ReportReason = "other"
+)
+
+type ReportStatus string
+
+const (
+	OpenReport     ReportStatus = "open"
+	ResolvedReport ReportStatus = "resolved"
+)
+
+type ContentFlag string
+
+const (
+	BannedWordContentFlag ContentFlag = "banned_word"
+	LinkSpamContentFlag   ContentFlag = "link_spam"
+	RepetitionContentFlag ContentFlag = "repetition"
+	ShoutingContentFlag   ContentFlag = "shouting"
+)
+
+type ModerationAction string
+
+const (
+	HideModerationAction    ModerationAction = "hide"
+	RestoreModerationAction ModerationAction = "restore"
+	DeleteModerationAction  ModerationAction = "delete"
+	WarnModerationAction    ModerationAction = "warn"
+)
+
+type EvaluationReport struct {
+	BaseModel
+	EvaluationID uuid.UUID        `gorm:"column:EvaluationId;type:char(36);not null;uniqueIndex:idx_evaluation_reports_evaluation_user"`
+	UserID       uuid.UUID        `gorm:"column:UserId;type:char(36);not null;uniqueIndex:idx_evaluation_reports_evaluation_user"`
+	Reason       ReportReason     `gorm:"column:Reason;type:varchar(20);not null"`
+	Details      string           `gorm:"column:Details;type:varchar(500)"`
+	Status       ReportStatus     `gorm:"column:Status;type:varchar(20);not null;default:'open';index"`
+	Resolution   ModerationAction `gorm:"column:Resolution;type:varchar(20)"`
+	ResolvedBy   *uuid.UUID       `gorm:"column:ResolvedBy;type:char(36);null;default:null"`
+	ResolvedAt   sql.NullTime     `gorm:"column:ResolvedAt;null;default:null"`
+	User         User             `gorm:"foreignKey:UserID;references:ID"`
+}
+
+func (er *EvaluationReport) TableName() string {
+	return "EvaluationReports"
+}
+
+type ReportEvaluationPayload struct {
+	Reason  ReportReason `json:"reason" validate:"required,oneof=spam offensive spoiler other"`
+	Details string       `json:"details" validate:"max=500"`
+}
+
+type WarnUserPayload struct {
+	Message string `json:"message" validate:"required,min=1,max=500"`
+}
+
+type EvaluationReportResponse struct {
+	ID        string       `json:"id"`
+	Reason    ReportReason `json:"reason"`
+	Details   string       `json:"details,omitempty"`
+	CreatedAt time.Time    `json:"createdAt"`
+}
+
+type ModerationEvaluationResponse struct {
+	ID           string                     `json:"id"`
+	BookID       string                     `json:"bookId"`
+	BookTitle    string                     `json:"bookTitle"`
+	UserID       string                     `json:"userId"`
+	UserFullName string                     `json:"userFullName"`
+	Rate         uint8                      `json:"rate"`
+	Description  string                     `json:"description"`
+	Status       EvaluationStatus           `json:"status"`
+	FilterFlags  []string                   `json:"filterFlags,omitempty"`
+	CreatedAt    time.Time                  `json:"createdAt"`
+	Reports      []EvaluationReportResponse `json:"reports"`
+}
+
+func (rep *ReportEvaluationPayload) ToEvaluationReport(evaluationID, userID uuid.UUID) *EvaluationReport {
+	ID, _ := uuid.NewV7()
+
+	return &EvaluationReport{
+		BaseModel: BaseModel{
+			ID:        ID,
+			CreatedAt: time.Now().UTC(),
+		},
+		EvaluationID: evaluationID,
+		UserID:       userID,
+		Reason:       rep.Reason,
+		Details:      rep.Details,
+		Status:       OpenReport,
+	}
+}
+
+func (er *EvaluationReport) ToEvaluationReportResponse() *EvaluationReportResponse {
+	return &EvaluationReportResponse{
+		ID:        er.ID.String(),
+		Reason:    er.Reason,
+		Details:   er.Details,
+		CreatedAt: er.CreatedAt,
+	}
+}
+
+func (e *Evaluation) ToModerationEvaluationResponse() *ModerationEvaluationResponse {
+	reports := make([]EvaluationReportResponse, 0, len(e.Reports))
+	for _, report := range e.Reports {
+		reports = append(reports, *report.ToEvaluationReportResponse())
+	}
+
+	return &ModerationEvaluationResponse{
+		ID:           e.ID.String(),
+		BookID:       e.BookID.String(),
+		BookTitle:    e.Book.Title,
+		UserID:       e.UserID.String(),
+		UserFullName: e.User.FullName,
+		Rate:         e.Rate,
+		Description:  e.Description,
+		Status:       e.Status,
+		FilterFlags:  e.GetFilterFlags(),
+		CreatedAt:    e.CreatedAt,
+		Reports:      reports,
+	}
+}
+
+func (e *Evaluation) HoldForModeration(flags []ContentFlag) {
+	values := make([]string, 0, len(flags))
+	for _, flag := range flags {
+		values = append(values, string(flag))
+	}
+
+	e.Status = PendingEvaluation
+	e.FilterFlags = strings.Join(values, ",")
+}
+
+func (e *Evaluation) GetFilterFlags() []string {
+	if e.FilterFlags == "" {
+		return nil
+	}
+
+	return strings.Split(e.FilterFlags, ",")
+}
+
+func NewModerationPagination(page, limit string) (*Pagination, error) {
+	return NewPagination(page, limit, "CreatedAt ASC")
+}
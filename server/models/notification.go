@@ -0,0 +1,173 @@
+package models
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+)
+
+var (
+	ErrNotificationNotFound = errors.New("notification not found in database")
+)
+
+type NotificationType string
+
+const (
+	NewBookNotification           NotificationType = "new_book"
+	EvaluationCommentNotification NotificationType = "evaluation_comment"
+	EvaluationHiddenNotification  NotificationType = "evaluation_hidden"
+	ModerationWarningNotification NotificationType = "moderation_warning"
+)
+
+type Notification struct {
+	BaseModel
+	UserID    uuid.UUID        `gorm:"column:UserId;type:char(36);not null;uniqueIndex:idx_notifications_user_dedup;index:idx_notifications_user_read"`
+	Type      NotificationType `gorm:"column:Type;type:varchar(30);not null"`
+	BookID    *uuid.UUID       `gorm:"column:BookId;type:char(36);null;default:null;index"`
+	Data      NotificationData `gorm:"column:Data;type:json"`
+	DedupKey  sql.NullString   `gorm:"column:DedupKey;type:varchar(150);null;default:null;uniqueIndex:idx_notifications_user_dedup"`
+	SendEmail bool             `gorm:"column:SendEmail;type:TINYINT;not null;default:0"`
+	EmailedAt sql.NullTime     `gorm:"column:EmailedAt;null;default:null"`
+	ReadAt    sql.NullTime     `gorm:"column:ReadAt;null;default:null;index:idx_notifications_user_read"`
+	User      User             `gorm:"foreignKey:UserID;references:ID"`
+	Book      *Book            `gorm:"foreignKey:BookID;references:ID"`
+}
+
+func (n *Notification) TableName() string {
+	return "Notifications"
+}
+
+type NotificationData map[string]string
+
+func (nd NotificationData) Value() (driver.Value, error) {
+	if len(nd) == 0 {
+		return nil, nil
+	}
+
+	return jsoniter.MarshalToString(nd)
+}
+
+func (nd *NotificationData) Scan(value any) error {
+	if value == nil {
+		*nd = nil
+		return nil
+	}
+
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("scan notification data: unsupported type %T", value)
+	}
+
+	return jsoniter.Unmarshal(data, nd)
+}
+
+type NotificationPagination struct {
+	Pagination
+	Unread bool `json:"unread"`
+}
+
+type NotificationTask struct {
+	UserIDs   []uuid.UUID       `json:"userIds"`
+	Type      NotificationType  `json:"type"`
+	BookID    *uuid.UUID        `json:"bookId,omitempty"`
+	Data      map[string]string `json:"data,omitempty"`
+	DedupKey  string            `json:"dedupKey,omitempty"`
+	SendEmail bool              `json:"sendEmail"`
+}
+
+type NotificationCreatedEventData struct {
+	Type   NotificationType `json:"type"`
+	BookID *uuid.UUID       `json:"bookId,omitempty"`
+}
+
+type BookFollowersTask struct {
+	BookID uuid.UUID `json:"bookId"`
+}
+
+type NotificationResponse struct {
+	ID        string            `json:"id"`
+	Type      NotificationType  `json:"type"`
+	BookID    *string           `json:"bookId,omitempty"`
+	Data      map[string]string `json:"data,omitempty"`
+	Read      bool              `json:"read"`
+	ReadAt    *time.Time        `json:"readAt,omitempty"`
+	CreatedAt time.Time         `json:"createdAt"`
+}
+
+type UnreadNotificationsResponse struct {
+	Count int64 `json:"count"`
+}
+
+func (n *Notification) ToNotificationResponse() *NotificationResponse {
+	response := &NotificationResponse{
+		ID:        n.ID.String(),
+		Type:      n.Type,
+		Data:      n.Data,
+		Read:      n.ReadAt.Valid,
+		CreatedAt: n.CreatedAt,
+	}
+
+	if n.BookID != nil {
+		bookID := n.BookID.String()
+		response.BookID = &bookID
+	}
+
+	if n.ReadAt.Valid {
+		response.ReadAt = &n.ReadAt.Time
+	}
+
+	return response
+}
+
+func (nt *NotificationTask) ToNotifications(now time.Time) []Notification {
+	notifications := make([]Notification, 0, len(nt.UserIDs))
+	for _, userID := range nt.UserIDs {
+		ID, _ := uuid.NewV7()
+
+		notification := Notification{
+			BaseModel: BaseModel{
+				ID:        ID,
+				CreatedAt: now,
+			},
+			UserID:    userID,
+			Type:      nt.Type,
+			BookID:    nt.BookID,
+			Data:      nt.Data,
+			SendEmail: nt.SendEmail,
+		}
+
+		if nt.DedupKey != "" {
+			notification.DedupKey = sql.NullString{String: nt.DedupKey, Valid: true}
+		}
+
+		notifications = append(notifications, notification)
+	}
+
+	return notifications
+}
+
+func NewBookDedupKey(bookID uuid.UUID) string {
+	return fmt.Sprintf("%s:%s", NewBookNotification, bookID.String())
+}
+
+func NewNotificationPagination(page, limit, unread string) (*NotificationPagination, error) {
+	pagination, err := NewPagination(page, limit, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &NotificationPagination{
+		Pagination: *pagination,
+		Unread:     unread == "true",
+	}, nil
+}
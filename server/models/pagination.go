@@ -38,6 +38,19 @@ func NewPagination(pageStr, limitStr, sort string) (*Pagination, error) {
 	}, nil
 }
 
+func ParseLimit(value string, defaultLimit, maxLimit int) (int, error) {
+	if value == "" {
+		return defaultLimit, nil
+	}
+
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 1 || limit > maxLimit {
+		return 0, ErrInvalidLimitParameter
+	}
+
+	return limit, nil
+}
+
 type PaginatedResponse[T any] struct {
 	Data       []T   `json:"data"`
 	Total      int64 `json:"total"`
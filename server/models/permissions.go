@@ -3,27 +3,34 @@ package models
 type Permission string
 
 const (
-	AllPermissions              Permission = "all_permissions"
-	CreateAdminPermission       Permission = "create_admin"
-	ListExternalBooksPermission Permission = "list_external_books"
-	GetExternalBooksPermission  Permission = "get_external_book"
-	CreateAuthorPermission      Permission = "create_author"
-	ListAuthorsPermission       Permission = "list_authors"
-	GetAuthorPermission         Permission = "get_author"
-	DeleteAuthorPermission      Permission = "delete_author"
-	CreateBookPermission        Permission = "create_book"
-	UpdateBookPermission        Permission = "update_book"
-	PublishBookPermission       Permission = "publish_book"
-	UnpublishBookPermission     Permission = "unpublish_book"
-	DeleteBookPermission        Permission = "delete_book"
-	ListBooksPermission         Permission = "list_book"
-	GetBookPermission           Permission = "get_book"
-	ListAdminsPermission        Permission = "list_admins"
-	BlockAdminPermission        Permission = "block_admin"
-	UnblockAdminPermission      Permission = "unblock_admin"
-	DeleteAdminPermission       Permission = "delete_admin"
-	GetAdminPermission          Permission = "get_admin"
-	UpdateAdminPermission       Permission = "update_admin"
+	AllPermissions                Permission = "all_permissions"
+	CreateAdminPermission         Permission = "create_admin"
+	ListExternalBooksPermission   Permission = "list_external_books"
+	GetExternalBooksPermission    Permission = "get_external_book"
+	CreateAuthorPermission        Permission = "create_author"
+	ListAuthorsPermission         Permission = "list_authors"
+	GetAuthorPermission           Permission = "get_author"
+	DeleteAuthorPermission        Permission = "delete_author"
+	MergeAuthorPermission         Permission = "merge_author"
+	CreateBookPermission          Permission = "create_book"
+	UpdateBookPermission          Permission = "update_book"
+	PublishBookPermission         Permission = "publish_book"
+	UnpublishBookPermission       Permission = "unpublish_book"
+	DeleteBookPermission          Permission = "delete_book"
+	ListBooksPermission           Permission = "list_book"
+	GetBookPermission             Permission = "get_book"
+	CreateCategoryPermission      Permission = "create_category"
+	UpdateCategoryPermission      Permission = "update_category"
+	MergeCategoryPermission       Permission = "merge_category"
+	DeleteCategoryPermission      Permission = "delete_category"
+	DeleteCommentPermission       Permission = "delete_comment"
+	ModerateEvaluationsPermission Permission = "moderate_evaluations"
+	ListAdminsPermission          Permission = "list_admins"
+	BlockAdminPermission          Permission = "block_admin"
+	UnblockAdminPermission        Permission = "unblock_admin"
+	DeleteAdminPermission         Permission = "delete_admin"
+	GetAdminPermission            Permission = "get_admin"
+	UpdateAdminPermission         Permission = "update_admin"
 )
 
 var rolePermissions = map[Role][]Permission{
@@ -37,6 +44,7 @@ var rolePermissions = map[Role][]Permission{
 		ListAuthorsPermission,
 		GetAuthorPermission,
 		DeleteAuthorPermission,
+		MergeAuthorPermission,
 		CreateBookPermission,
 		UpdateBookPermission,
 		PublishBookPermission,
@@ -44,6 +52,12 @@ var rolePermissions = map[Role][]Permission{
 		DeleteBookPermission,
 		ListBooksPermission,
 		GetBookPermission,
+		CreateCategoryPermission,
+		UpdateCategoryPermission,
+		MergeCategoryPermission,
+		DeleteCategoryPermission,
+		DeleteCommentPermission,
+		ModerateEvaluationsPermission,
 	},
 	Member: {},
 }
@@ -0,0 +1,137 @@
+package models
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AnonymousUserName         = "Leitor anônimo"
+	ProfileRecentReviewsLimit = 5
+	ProfilePublicListsLimit   = 10
+)
+
+var (
+	ErrProfileNotFound    = errors.New("profile not found or hidden by the user")
+	ErrInvalidHandle      = errors.New("handle must have 3 to 30 lowercase letters, digits or underscores")
+	ErrHandleAlreadyTaken = errors.New("handle already claimed by another user")
+)
+
+var handlePattern = regexp.MustCompile(`^[a-z0-9_]{3,30}$`)
+
+var reservedHandles = map[string]bool{
+	"me":       true,
+	"admin":    true,
+	"bookwise": true,
+	"support":  true,
+}
+
+type ClaimHandlePayload struct {
+	Handle string `json:"handle" validate:"required,min=3,max=31"`
+}
+
+type UpdatePrivacyPayload struct {
+	HideProfile bool `json:"hideProfile"`
+	HideReviews bool `json:"hideReviews"`
+	HideShelves bool `json:"hideShelves"`
+}
+
+type PrivacyResponse struct {
+	HideProfile bool `json:"hideProfile"`
+	HideReviews bool `json:"hideReviews"`
+	HideShelves bool `json:"hideShelves"`
+}
+
+type PublicUserResponse struct {
+	ID        string `json:"id,omitempty"`
+	Handle    string `json:"handle,omitempty"`
+	FullName  string `json:"fullName"`
+	AvatarURL string `json:"avatarUrl,omitempty"`
+}
+
+type ProfileReviewResponse struct {
+	EvaluationBasicInfoResponse
+	Book *BookSummaryResponse `json:"book"`
+}
+
+type ProfileResponse struct {
+	PublicUserResponse
+	BooksRead     *int64                  `json:"booksRead,omitempty"`
+	RecentReviews []ProfileReviewResponse `json:"recentReviews,omitempty"`
+	PublicLists   []*BookListResponse     `json:"publicLists"`
+	ReviewsHidden bool                    `json:"reviewsHidden"`
+	ShelvesHidden bool                    `json:"shelvesHidden"`
+	FollowedByMe  bool                    `json:"followedByMe"`
+}
+
+// NormalizeHandle lowercases the handle and strips a leading "@", so "@Ana_B"
+// and "ana_b" address the same profile.
+func NormalizeHandle(handle string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(handle), "@"))
+}
+
+func ValidateHandle(handle string) error {
+	if !handlePattern.MatchString(handle) || reservedHandles[handle] {
+		return ErrInvalidHandle
+	}
+
+	return nil
+}
+
+// IsProfileVisibleTo reports whether the viewer may see who the user is. Users
+// who hide their profile are shown anonymously everywhere except to themselves.
+func (u *User) IsProfileVisibleTo(viewerID uuid.UUID) bool {
+	return u.ID == viewerID || !u.HideProfile
+}
+
+func (u *User) AreReviewsVisibleTo(viewerID uuid.UUID) bool {
+	return u.ID == viewerID || (!u.HideProfile && !u.HideReviews)
+}
+
+func (u *User) AreShelvesVisibleTo(viewerID uuid.UUID) bool {
+	return u.ID == viewerID || (!u.HideProfile && !u.HideShelves)
+}
+
+func (u *User) ApplyPrivacy(payload UpdatePrivacyPayload) {
+	u.HideProfile = payload.HideProfile
+	u.HideReviews = payload.HideReviews
+	u.HideShelves = payload.HideShelves
+}
+
+func (u *User) ToPrivacyResponse() *PrivacyResponse {
+	return &PrivacyResponse{
+		HideProfile: u.HideProfile,
+		HideReviews: u.HideReviews,
+		HideShelves: u.HideShelves,
+	}
+}
+
+func (u *User) ToPublicUserResponse(viewerID uuid.UUID) *PublicUserResponse {
+	if !u.IsProfileVisibleTo(viewerID) {
+		return &PublicUserResponse{FullName: AnonymousUserName}
+	}
+
+	return &PublicUserResponse{
+		ID:        u.ID.String(),
+		Handle:    u.Handle.String,
+		FullName:  u.FullName,
+		AvatarURL: u.AvatarVariants.URL(SmallImageVariant, u.Avatar.String),
+	}
+}
+
+func (u *User) ToProfileResponse(viewerID uuid.UUID) *ProfileResponse {
+	return &ProfileResponse{
+		PublicUserResponse: PublicUserResponse{
+			ID:        u.ID.String(),
+			Handle:    u.Handle.String,
+			FullName:  u.FullName,
+			AvatarURL: u.AvatarVariants.URL(MediumImageVariant, u.Avatar.String),
+		},
+		PublicLists:   make([]*BookListResponse, 0),
+		ReviewsHidden: !u.AreReviewsVisibleTo(viewerID),
+		ShelvesHidden: !u.AreShelvesVisibleTo(viewerID),
+	}
+}
@@ -0,0 +1,115 @@
+package models
+
+import (
+	"database/sql"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrReadingChallengeNotFound = errors.New("reading challenge not found")
+	ErrInvalidChallengeYear     = errors.New("reading challenge year must be the current or the next year")
+)
+
+type ChallengePace string
+
+const (
+	AheadOfSchedulePace ChallengePace = "ahead"
+	OnTrackPace         ChallengePace = "on_track"
+	BehindSchedulePace  ChallengePace = "behind"
+	CompletedChallenge  ChallengePace = "completed"
+	NotStartedChallenge ChallengePace = "not_started"
+)
+
+type ReadingChallenge struct {
+	BaseModel
+	UserID        uuid.UUID    `gorm:"column:UserId;type:char(36);not null;uniqueIndex:idx_reading_challenges_user_year"`
+	Year          int          `gorm:"column:Year;not null;uniqueIndex:idx_reading_challenges_user_year;index"`
+	Goal          uint         `gorm:"column:Goal;type:INT UNSIGNED;not null"`
+	SummarySentAt sql.NullTime `gorm:"column:SummarySentAt;null;default:null"`
+	User          User         `gorm:"foreignKey:UserID;references:ID"`
+}
+
+func (rc *ReadingChallenge) TableName() string {
+	return "ReadingChallenges"
+}
+
+type SetReadingChallengePayload struct {
+	Goal uint `json:"goal" validate:"required,min=1,max=1000"`
+}
+
+type ReadingChallengeResponse struct {
+	Year          int           `json:"year"`
+	Goal          uint          `json:"goal"`
+	BooksFinished int64         `json:"booksFinished"`
+	Percentage    float32       `json:"percentage"`
+	ExpectedBooks int64         `json:"expectedBooks"`
+	BooksAhead    int64         `json:"booksAhead"`
+	BooksBehind   int64         `json:"booksBehind"`
+	Pace          ChallengePace `json:"pace"`
+}
+
+func NewReadingChallenge(userID uuid.UUID, year int, goal uint) *ReadingChallenge {
+	ID, _ := uuid.NewV7()
+
+	return &ReadingChallenge{
+		BaseModel: BaseModel{
+			ID:        ID,
+			CreatedAt: time.Now().UTC(),
+		},
+		UserID: userID,
+		Year:   year,
+		Goal:   goal,
+	}
+}
+
+// ExpectedBooks returns how many books should have been finished by now to
+// reach the goal reading at a steady pace throughout the year.
+func (rc *ReadingChallenge) ExpectedBooks(now time.Time) int64 {
+	start := time.Date(rc.Year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0)
+
+	switch {
+	case now.Before(start):
+		return 0
+	case !now.Before(end):
+		return int64(rc.Goal)
+	}
+
+	elapsed := now.Sub(start).Hours() / end.Sub(start).Hours()
+	return int64(math.Floor(float64(rc.Goal) * elapsed))
+}
+
+func (rc *ReadingChallenge) ToReadingChallengeResponse(booksFinished int64, now time.Time) *ReadingChallengeResponse {
+	expected := rc.ExpectedBooks(now)
+	response := &ReadingChallengeResponse{
+		Year:          rc.Year,
+		Goal:          rc.Goal,
+		BooksFinished: booksFinished,
+		ExpectedBooks: expected,
+	}
+
+	if rc.Goal > 0 {
+		response.Percentage = float32(math.Min(float64(booksFinished)*100/float64(rc.Goal), 100))
+	}
+
+	switch {
+	case booksFinished >= int64(rc.Goal):
+		response.Pace = CompletedChallenge
+	case now.Year() < rc.Year:
+		response.Pace = NotStartedChallenge
+	case booksFinished > expected:
+		response.Pace = AheadOfSchedulePace
+		response.BooksAhead = booksFinished - expected
+	case booksFinished < expected:
+		response.Pace = BehindSchedulePace
+		response.BooksBehind = expected - booksFinished
+	default:
+		response.Pace = OnTrackPace
+	}
+
+	return response
+}
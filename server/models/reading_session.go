@@ -0,0 +1,185 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const ReadingSessionDateLayout = "2006-01-02"
+
+var (
+	ErrReadingSessionNotFound    = errors.New("reading session not found")
+	ErrInvalidReadingSessionDate = errors.New("reading session date cannot be in the future")
+)
+
+type ReadingSession struct {
+	BaseModel
+	UserID    uuid.UUID `gorm:"column:UserId;type:char(36);not null;index:idx_reading_sessions_user_book"`
+	BookID    uuid.UUID `gorm:"column:BookId;type:char(36);not null;index:idx_reading_sessions_user_book"`
+	Date      time.Time `gorm:"column:Date;type:date;not null;index"`
+	PagesRead uint      `gorm:"column:PagesRead;type:INT UNSIGNED;not null"`
+	Minutes   uint      `gorm:"column:Minutes;type:INT UNSIGNED;not null;default:0"`
+	User      User      `gorm:"foreignKey:UserID;references:ID"`
+	Book      Book      `gorm:"foreignKey:BookID;references:ID"`
+}
+
+func (rs *ReadingSession) TableName() string {
+	return "ReadingSessions"
+}
+
+type CreateReadingSessionPayload struct {
+	Date      string `json:"date" validate:"required,datetime=2006-01-02"`
+	PagesRead uint   `json:"pagesRead" validate:"required,min=1,max=5000"`
+	Minutes   uint   `json:"minutes" validate:"max=1440"`
+}
+
+type ReadingSessionResponse struct {
+	ID        string `json:"id"`
+	Date      string `json:"date"`
+	PagesRead uint   `json:"pagesRead"`
+	Minutes   uint   `json:"minutes"`
+}
+
+type ReadingProgress struct {
+	PagesRead     int64 `gorm:"column:PagesRead"`
+	Minutes       int64 `gorm:"column:Minutes"`
+	TotalSessions int64 `gorm:"column:TotalSessions"`
+}
+
+type ReadingProgressResponse struct {
+	PagesRead     uint               `json:"pagesRead"`
+	TotalPages    uint               `json:"totalPages"`
+	Percentage    float32            `json:"percentage"`
+	Minutes       int64              `json:"minutes"`
+	TotalSessions int64              `json:"totalSessions"`
+	Shelf         *BookShelfResponse `json:"shelf,omitempty"`
+}
+
+type ReadingTotals struct {
+	PagesRead int64 `gorm:"column:PagesRead"`
+	Minutes   int64 `gorm:"column:Minutes"`
+	Sessions  int64 `gorm:"column:Sessions"`
+}
+
+type PeriodCount struct {
+	Period string `gorm:"column:Period"`
+	Total  int64  `gorm:"column:Total"`
+}
+
+type RatingSummary struct {
+	Average float32 `gorm:"column:Average"`
+	Total   int64   `gorm:"column:Total"`
+}
+
+type FavoriteCategory struct {
+	CategoryID   uuid.UUID            `gorm:"column:CategoryId"`
+	Name         string               `gorm:"column:Name"`
+	Translations CategoryTranslations `gorm:"column:Translations"`
+	TotalBooks   int64                `gorm:"column:TotalBooks"`
+}
+
+type WeeklyPagesResponse struct {
+	WeekStart string `json:"weekStart"`
+	Pages     int64  `json:"pages"`
+}
+
+type MonthlyFinishedBooksResponse struct {
+	Month string `json:"month"`
+	Books int64  `json:"books"`
+}
+
+type FavoriteCategoryResponse struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	TotalBooks int64  `json:"totalBooks"`
+}
+
+type ReadingStatsResponse struct {
+	TotalPagesRead     int64                          `json:"totalPagesRead"`
+	TotalMinutes       int64                          `json:"totalMinutes"`
+	TotalSessions      int64                          `json:"totalSessions"`
+	TotalBooksFinished int64                          `json:"totalBooksFinished"`
+	AverageRating      float32                        `json:"averageRating"`
+	TotalRatings       int64                          `json:"totalRatings"`
+	PagesPerWeek       []WeeklyPagesResponse          `json:"pagesPerWeek"`
+	BooksPerMonth      []MonthlyFinishedBooksResponse `json:"booksPerMonth"`
+	FavoriteCategories []FavoriteCategoryResponse     `json:"favoriteCategories"`
+}
+
+func (crp *CreateReadingSessionPayload) ToReadingSession(userID, bookID uuid.UUID) (*ReadingSession, error) {
+	date, err := time.Parse(ReadingSessionDateLayout, crp.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	if date.After(time.Now().UTC()) {
+		return nil, ErrInvalidReadingSessionDate
+	}
+
+	ID, _ := uuid.NewV7()
+
+	return &ReadingSession{
+		BaseModel: BaseModel{
+			ID:        ID,
+			CreatedAt: time.Now().UTC(),
+		},
+		UserID:    userID,
+		BookID:    bookID,
+		Date:      date,
+		PagesRead: crp.PagesRead,
+		Minutes:   crp.Minutes,
+	}, nil
+}
+
+func (rs *ReadingSession) ToReadingSessionResponse() *ReadingSessionResponse {
+	return &ReadingSessionResponse{
+		ID:        rs.ID.String(),
+		Date:      rs.Date.Format(ReadingSessionDateLayout),
+		PagesRead: rs.PagesRead,
+		Minutes:   rs.Minutes,
+	}
+}
+
+func (rp *ReadingProgress) ToReadingProgressResponse(totalPages uint, shelf *BookShelf) *ReadingProgressResponse {
+	pagesRead := uint(rp.PagesRead)
+	if totalPages > 0 && pagesRead > totalPages {
+		pagesRead = totalPages
+	}
+
+	response := &ReadingProgressResponse{
+		PagesRead:     pagesRead,
+		TotalPages:    totalPages,
+		Minutes:       rp.Minutes,
+		TotalSessions: rp.TotalSessions,
+	}
+
+	if totalPages > 0 {
+		response.Percentage = float32(pagesRead) * 100 / float32(totalPages)
+	}
+
+	if shelf != nil {
+		response.Shelf = shelf.ToBookShelfResponse()
+	}
+
+	return response
+}
+
+func (rp *ReadingProgress) IsFinished(totalPages uint) bool {
+	return totalPages > 0 && rp.PagesRead >= int64(totalPages)
+}
+
+func (fc *FavoriteCategory) ToFavoriteCategoryResponse(locale string) FavoriteCategoryResponse {
+	category := Category{Name: fc.Name, Translations: fc.Translations}
+
+	return FavoriteCategoryResponse{
+		ID:         fc.CategoryID.String(),
+		Name:       category.DisplayName(locale),
+		TotalBooks: fc.TotalBooks,
+	}
+}
+
+func NewReadingSessionPagination(page, limit string) (*Pagination, error) {
+	return NewPagination(page, limit, "Date DESC, CreatedAt DESC")
+}
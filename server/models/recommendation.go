@@ -0,0 +1,29 @@
+package models
+
+import (
+	"github.com/google/uuid"
+)
+
+const (
+	MaxRecommendedBooks          = 50
+	DefaultRecommendedBooksLimit = 20
+	MaxSimilarBooks              = 50
+	DefaultSimilarBooksLimit     = 10
+)
+
+type RecommendationTask struct {
+	UserID uuid.UUID `json:"userId"`
+}
+
+type BookAffinity struct {
+	BookID uuid.UUID `gorm:"column:BookId"`
+	Score  int64     `gorm:"column:Score"`
+}
+
+func ParseRecommendedBooksLimit(value string) (int, error) {
+	return ParseLimit(value, DefaultRecommendedBooksLimit, MaxRecommendedBooks)
+}
+
+func ParseSimilarBooksLimit(value string) (int, error) {
+	return ParseLimit(value, DefaultSimilarBooksLimit, MaxSimilarBooks)
+}
@@ -0,0 +1,96 @@
+package models
+
+import (
+	"html"
+	"strings"
+)
+
+const (
+	SpoilerOpenTag     = "[spoiler]"
+	SpoilerCloseTag    = "[/spoiler]"
+	SpoilerPlaceholder = "[spoiler]"
+)
+
+type DescriptionSegment struct {
+	Text    string `json:"text"`
+	Spoiler bool   `json:"spoiler"`
+}
+
+// ParseDescription splits a review written with [spoiler]...[/spoiler] markup
+// into plain and spoiler segments. Unclosed tags are kept as text.
+func ParseDescription(raw string) []DescriptionSegment {
+	var segments []DescriptionSegment
+	rest := raw
+	for {
+		start := strings.Index(rest, SpoilerOpenTag)
+		if start < 0 {
+			break
+		}
+
+		end := strings.Index(rest[start+len(SpoilerOpenTag):], SpoilerCloseTag)
+		if end < 0 {
+			break
+		}
+
+		content := rest[start+len(SpoilerOpenTag) : start+len(SpoilerOpenTag)+end]
+		segments = appendSegment(segments, rest[:start], false)
+		segments = appendSegment(segments, content, strings.TrimSpace(content) != "")
+		rest = rest[start+len(SpoilerOpenTag)+end+len(SpoilerCloseTag):]
+	}
+
+	return appendSegment(segments, rest, false)
+}
+
+func appendSegment(segments []DescriptionSegment, text string, spoiler bool) []DescriptionSegment {
+	if text == "" {
+		return segments
+	}
+
+	if last := len(segments) - 1; last >= 0 && segments[last].Spoiler == spoiler {
+		segments[last].Text += text
+		return segments
+	}
+
+	return append(segments, DescriptionSegment{Text: text, Spoiler: spoiler})
+}
+
+func HasSpoilerSegments(segments []DescriptionSegment) bool {
+	for _, segment := range segments {
+		if segment.Spoiler {
+			return true
+		}
+	}
+
+	return false
+}
+
+func RenderDescriptionHTML(segments []DescriptionSegment) string {
+	var builder strings.Builder
+	for _, segment := range segments {
+		text := strings.ReplaceAll(html.EscapeString(segment.Text), "\n", "<br>")
+		if segment.Spoiler {
+			builder.WriteString(`<span class="spoiler">`)
+			builder.WriteString(text)
+			builder.WriteString("</span>")
+			continue
+		}
+
+		builder.WriteString(text)
+	}
+
+	return builder.String()
+}
+
+func RenderDescriptionText(segments []DescriptionSegment) string {
+	var builder strings.Builder
+	for _, segment := range segments {
+		if segment.Spoiler {
+			builder.WriteString(SpoilerPlaceholder)
+			continue
+		}
+
+		builder.WriteString(segment.Text)
+	}
+
+	return builder.String()
+}
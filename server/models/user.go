@@ -41,11 +41,17 @@ const (
 
 type User struct {
 	BaseModel
-	FullName string         `gorm:"column:FullName;type:varchar(255);not null"`
-	Email    string         `gorm:"column:Email;type:varchar(255);not null;unique"`
-	Status   Status         `gorm:"column:Status;type:enum('active', 'blocked');not null;default:'active';index"`
-	Role     Role           `gorm:"column:Role;type:enum('member', 'admin', 'owner');not null;default:'member';index"`
-	Avatar   sql.NullString `gorm:"column:Avatar;type:varchar(255)"`
+	FullName            string         `gorm:"column:FullName;type:varchar(255);not null"`
+	Email               string         `gorm:"column:Email;type:varchar(255);not null;unique"`
+	Status              Status         `gorm:"column:Status;type:enum('active', 'blocked');not null;default:'active';index"`
+	Role                Role           `gorm:"column:Role;type:enum('member', 'admin', 'owner');not null;default:'member';index"`
+	Avatar              sql.NullString `gorm:"column:Avatar;type:varchar(255)"`
+	AvatarImageClientID uuid.UUID      `gorm:"column:AvatarImageClientId;type:char(36);index"`
+	AvatarVariants      ImageVariants  `gorm:"column:AvatarVariants;type:json"`
+	Handle              sql.NullString `gorm:"column:Handle;type:varchar(30);null;default:null;uniqueIndex"`
+	HideProfile         bool           `gorm:"column:HideProfile;type:TINYINT;not null;default:0"`
+	HideReviews         bool           `gorm:"column:HideReviews;type:TINYINT;not null;default:0"`
+	HideShelves         bool           `gorm:"column:HideShelves;type:TINYINT;not null;default:0"`
 }
 
 func (u *User) TableName() string {
@@ -79,11 +85,14 @@ type UpdateAdminPayload struct {
 }
 
 type UserResponse struct {
-	ID       string `json:"id"`
-	FullName string `json:"fullName"`
-	Email    string `json:"email"`
-	Role     string `json:"role"`
-	Avatar   string `json:"avatar,omitempty"`
+	ID             string            `json:"id"`
+	FullName       string            `json:"fullName"`
+	Email          string            `json:"email"`
+	Role           string            `json:"role"`
+	Avatar         string            `json:"avatar,omitempty"`
+	AvatarVariants map[string]string `json:"avatarVariants,omitempty"`
+	Handle         string            `json:"handle,omitempty"`
+	Privacy        PrivacyResponse   `json:"privacy"`
 }
 
 type AdminDetailsResponse struct {
@@ -114,13 +123,20 @@ func (cup *CreateUserPayload) ToUser(role Role) *User {
 	}
 }
 
+func (u *User) AvatarImageIDs() []uuid.UUID {
+	return collectImageIDs(u.AvatarImageClientID, u.AvatarVariants)
+}
+
 func (u *User) ToUserResponse() *UserResponse {
 	return &UserResponse{
-		ID:       u.ID.String(),
-		FullName: u.FullName,
-		Email:    u.Email,
-		Role:     string(u.Role),
-		Avatar:   u.Avatar.String,
+		ID:             u.ID.String(),
+		FullName:       u.FullName,
+		Email:          u.Email,
+		Role:           string(u.Role),
+		Avatar:         u.AvatarVariants.URL(MediumImageVariant, u.Avatar.String),
+		AvatarVariants: u.AvatarVariants.URLs(),
+		Handle:         u.Handle.String,
+		Privacy:        *u.ToPrivacyResponse(),
 	}
 }
 
@@ -131,7 +147,7 @@ func (u *User) ToAdminDetailsResponse() *AdminDetailsResponse {
 		Email:     u.Email,
 		Role:      string(u.Role),
 		Status:    string(u.Status),
-		Avatar:    u.Avatar.String,
+		Avatar:    u.AvatarVariants.URL(MediumImageVariant, u.Avatar.String),
 		CreatedAt: u.CreatedAt,
 	}
 }
@@ -0,0 +1,109 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ActivityRepository interface {
+	CreateActivity(ctx context.Context, activity *models.Activity) error
+	GetActivitiesByIDs(ctx context.Context, IDs []uuid.UUID) ([]models.Activity, error)
+	GetFeedActivities(ctx context.Context, userID uuid.UUID, cursor *uuid.UUID, limit int) ([]models.Activity, error)
+	GetFeedActivityIDs(ctx context.Context, userID uuid.UUID, limit int) ([]uuid.UUID, error)
+}
+
+type activityRepository struct {
+	di *internal.Di
+	DB *gorm.DB
+}
+
+func NewActivityRepository(di *internal.Di) (ActivityRepository, error) {
+	DB, err := internal.Invoke[*gorm.DB](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &activityRepository{
+		di: di,
+		DB: DB,
+	}, nil
+}
+
+func (a *activityRepository) CreateActivity(ctx context.Context, activity *models.Activity) error {
+	if err := a.DB.WithContext(ctx).
+		Omit("Actor", "Book", "Evaluation", "BookList").
+		Create(activity).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *activityRepository) GetActivitiesByIDs(ctx context.Context, IDs []uuid.UUID) ([]models.Activity, error) {
+	if len(IDs) == 0 {
+		return nil, nil
+	}
+
+	var activities []models.Activity
+	if err := a.withFeedPreloads(ctx).
+		Where("Id IN ?", IDs).
+		Order("Id DESC").
+		Find(&activities).Error; err != nil {
+		return nil, err
+	}
+
+	return activities, nil
+}
+
+func (a *activityRepository) GetFeedActivities(ctx context.Context, userID uuid.UUID, cursor *uuid.UUID, limit int) ([]models.Activity, error) {
+	query := a.withFeedPreloads(ctx).
+		Where("ActorId IN (?)", a.followedUserIDs(userID))
+
+	if cursor != nil {
+		query = query.Where("Id < ?", *cursor)
+	}
+
+	var activities []models.Activity
+	if err := query.
+		Order("Id DESC").
+		Limit(limit).
+		Find(&activities).Error; err != nil {
+		return nil, err
+	}
+
+	return activities, nil
+}
+
+func (a *activityRepository) GetFeedActivityIDs(ctx context.Context, userID uuid.UUID, limit int) ([]uuid.UUID, error) {
+	var IDs []uuid.UUID
+	if err := a.DB.WithContext(ctx).
+		Model(&models.Activity{}).
+		Where("ActorId IN (?)", a.followedUserIDs(userID)).
+		Order("Id DESC").
+		Limit(limit).
+		Pluck("Id", &IDs).Error; err != nil {
+		return nil, err
+	}
+
+	return IDs, nil
+}
+
+func (a *activityRepository) followedUserIDs(userID uuid.UUID) *gorm.DB {
+	return a.DB.
+		Model(&models.Follow{}).
+		Select("TargetId").
+		Where("UserId = ? AND TargetType = ?", userID, models.UserFollowTarget)
+}
+
+func (a *activityRepository) withFeedPreloads(ctx context.Context) *gorm.DB {
+	return a.DB.WithContext(ctx).
+		Preload("Actor").
+		Preload("Book").
+		Preload("Book.Authors").
+		Preload("Evaluation").
+		Preload("BookList")
+}
@@ -14,12 +14,13 @@ import (
 
 type AuthorRepository interface {
 	CreateAuthor(ctx context.Context, author models.Author) error
-	UpdateAuthorAvatar(ctx context.Context, authorID, avatarImageClientID uuid.UUID, avatarURL string) error
+	UpdateAuthorAvatar(ctx context.Context, authorID uuid.UUID, avatar models.ImageVariants) error
 	GetAllAuthors(ctx context.Context) ([]models.Author, error)
 	GetPaginatedAuthors(ctx context.Context, pagination *models.AuthorPagination) (*models.PaginatedResponse[models.Author], error)
 	DeleteAuthorByID(ctx context.Context, ID uuid.UUID) error
 	GetAuthorByID(ctx context.Context, ID uuid.UUID) (*models.Author, error)
 	GetAuthorsByID(ctx context.Context, IDs []uuid.UUID) ([]models.Author, error)
+	MergeAuthors(ctx context.Context, sourceID, targetID uuid.UUID) error
 }
 
 type authorRepository struct {
@@ -61,11 +62,17 @@ func (a *authorRepository) GetAllAuthors(ctx context.Context) ([]models.Author,
 	return authors, nil
 }
 
-func (a *authorRepository) UpdateAuthorAvatar(ctx context.Context, authorID, avatarImageClientID uuid.UUID, avatarURL string) error {
+func (a *authorRepository) UpdateAuthorAvatar(ctx context.Context, authorID uuid.UUID, avatar models.ImageVariants) error {
+	original := avatar[models.LargeImageVariant]
+
 	if err := a.DB.WithContext(ctx).
 		Model(&models.Author{}).
 		Where("id = ?", authorID.String()).
-		Updates(models.Author{AvatarURL: sql.NullString{String: avatarURL, Valid: avatarURL != ""}, AvatarImageClientID: avatarImageClientID}).Error; err != nil {
+		Updates(models.Author{
+			AvatarURL:           sql.NullString{String: original.URL, Valid: original.URL != ""},
+			AvatarImageClientID: original.ID,
+			AvatarVariants:      avatar,
+		}).Error; err != nil {
 		return err
 	}
 
@@ -134,3 +141,32 @@ func (a *authorRepository) GetAuthorsByID(ctx context.Context, IDs []uuid.UUID)
 
 	return authors, nil
 }
+
+func (a *authorRepository) MergeAuthors(ctx context.Context, sourceID, targetID uuid.UUID) error {
+	err := a.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`
+			UPDATE BookAuthors SET AuthorID = ?
+			WHERE AuthorID = ?
+			AND BookID NOT IN (
+				SELECT BookID FROM (SELECT BookID FROM BookAuthors WHERE AuthorID = ?) AS TargetBooks
+			)`, targetID, sourceID, targetID).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Exec("DELETE FROM BookAuthors WHERE AuthorID = ?", sourceID).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("Id = ?", sourceID).Delete(&models.Author{}).Error; err != nil {
+			return err
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
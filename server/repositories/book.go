@@ -17,8 +17,13 @@ type BookRepository interface {
 	GetPaginatedBooks(ctx context.Context, pagination *models.BookPagination) (*models.PaginatedResponse[models.Book], error)
 	DeleteBookByID(ctx context.Context, ID uuid.UUID) error
 	UpdatePublicationStatus(ctx context.Context, ID uuid.UUID, publishedStatus bool) error
-	DeleteBooksByAuthorID(ctx context.Context, authorID uuid.UUID) error
+	DeleteSoleAuthoredBooksByAuthorID(ctx context.Context, authorID uuid.UUID) ([]models.Book, error)
 	GetPaginatedPublishedBooks(ctx context.Context, pagination *models.PublishedBookPagination) (*models.PaginatedResponse[models.Book], error)
+	UpdateBookCover(ctx context.Context, ID uuid.UUID, cover models.ImageVariants) error
+	GetBookIDsByAuthorID(ctx context.Context, authorID uuid.UUID) ([]uuid.UUID, error)
+	GetBookIDsByCategoryID(ctx context.Context, categoryID uuid.UUID) ([]uuid.UUID, error)
+	CountPublishedBooksByAuthorID(ctx context.Context, authorID uuid.UUID) (int64, error)
+	GetBooksWithExternalCover(ctx context.Context) ([]models.Book, error)
 }
 
 type bookRepository struct {
@@ -135,11 +140,12 @@ func (r *bookRepository) UpdatePublicationStatus(ctx context.Context, ID uuid.UU
 	return nil
 }
 
-func (r *bookRepository) DeleteBooksByAuthorID(ctx context.Context, authorID uuid.UUID) error {
+func (r *bookRepository) DeleteSoleAuthoredBooksByAuthorID(ctx context.Context, authorID uuid.UUID) ([]models.Book, error) {
+	var books []models.Book
 	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		var books []models.Book
 		if err := tx.Joins("JOIN BookAuthors ON BookAuthors.BookID = Books.Id").
 			Where("BookAuthors.AuthorID = ?", authorID).
+			Where("Books.Id NOT IN (?)", tx.Table("BookAuthors").Select("BookID").Where("AuthorID <> ?", authorID)).
 			Find(&books).Error; err != nil {
 			return err
 		}
@@ -158,10 +164,10 @@ func (r *bookRepository) DeleteBooksByAuthorID(ctx context.Context, authorID uui
 	})
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return books, nil
 }
 
 func (r *bookRepository) GetPaginatedPublishedBooks(ctx context.Context, pagination *models.PublishedBookPagination) (*models.PaginatedResponse[models.Book], error) {
@@ -180,8 +186,19 @@ func (r *bookRepository) GetPaginatedPublishedBooks(ctx context.Context, paginat
 	}
 
 	if pagination.CategoryID != nil {
+		descendants := r.DB.WithContext(ctx).
+			Model(&models.Category{}).
+			Select("Categories.Id").
+			Joins("JOIN Categories AS Ancestor ON Ancestor.Id = ?", *pagination.CategoryID).
+			Where("Categories.Id = Ancestor.Id OR Categories.NormalizedName LIKE CONCAT(Ancestor.NormalizedName, ?)", models.CategoryPathSeparator+"%")
+
 		query = query.Joins("JOIN BookCategories ON BookCategories.BookID = Books.Id").
-			Where("BookCategories.CategoryID = ?", *pagination.CategoryID)
+			Where("BookCategories.CategoryID IN (?)", descendants)
+	}
+
+	if pagination.AuthorID != nil {
+		query = query.Joins("JOIN BookAuthors AS AuthorBooks ON AuthorBooks.BookID = Books.Id").
+			Where("AuthorBooks.AuthorID = ?", *pagination.AuthorID)
 	}
 
 	orders, err := paginate[models.Book](query, &pagination.Pagination, &models.Book{})
@@ -194,3 +211,73 @@ func (r *bookRepository) GetPaginatedPublishedBooks(ctx context.Context, paginat
 
 	return orders, nil
 }
+
+func (r *bookRepository) UpdateBookCover(ctx context.Context, ID uuid.UUID, cover models.ImageVariants) error {
+	original := cover[models.LargeImageVariant]
+
+	if err := r.DB.WithContext(ctx).
+		Model(&models.Book{}).
+		Where("Id = ?", ID.String()).
+		Updates(models.Book{
+			CoverImageURL:      original.URL,
+			CoverImageClientID: original.ID,
+			CoverVariants:      cover,
+		}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *bookRepository) GetBookIDsByAuthorID(ctx context.Context, authorID uuid.UUID) ([]uuid.UUID, error) {
+	var IDs []uuid.UUID
+	if err := r.DB.WithContext(ctx).
+		Table("BookAuthors").
+		Where("AuthorID = ?", authorID).
+		Pluck("BookID", &IDs).Error; err != nil {
+		return nil, err
+	}
+
+	return IDs, nil
+}
+
+func (r *bookRepository) GetBookIDsByCategoryID(ctx context.Context, categoryID uuid.UUID) ([]uuid.UUID, error) {
+	var IDs []uuid.UUID
+	if err := r.DB.WithContext(ctx).
+		Table("BookCategories").
+		Where("CategoryID = ?", categoryID).
+		Pluck("BookID", &IDs).Error; err != nil {
+		return nil, err
+	}
+
+	return IDs, nil
+}
+
+func (r *bookRepository) CountPublishedBooksByAuthorID(ctx context.Context, authorID uuid.UUID) (int64, error) {
+	var total int64
+	if err := r.DB.WithContext(ctx).
+		Model(&models.Book{}).
+		Joins("JOIN BookAuthors ON BookAuthors.BookID = Books.Id").
+		Where("BookAuthors.AuthorID = ? AND Books.Published = ?", authorID, true).
+		Count(&total).Error; err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// GetBooksWithExternalCover returns the books whose cover was never uploaded
+// and still points at the URL it was created with, e.g. Google Books.
+func (r *bookRepository) GetBooksWithExternalCover(ctx context.Context) ([]models.Book, error) {
+	var books []models.Book
+	if err := r.DB.WithContext(ctx).
+		Model(&models.Book{}).
+		Select("Id", "CoverImageUrl").
+		Where("CoverImageUrl <> ''").
+		Where("CoverImageClientId IS NULL OR CoverImageClientId = ?", uuid.Nil).
+		Find(&books).Error; err != nil {
+		return nil, err
+	}
+
+	return books, nil
+}
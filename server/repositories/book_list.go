@@ -0,0 +1,295 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BookListRepository interface {
+	CreateBookList(ctx context.Context, bookList *models.BookList) error
+	GetBookListByID(ctx context.Context, ID uuid.UUID, preloadItems bool) (*models.BookList, error)
+	UpdateBookList(ctx context.Context, bookList *models.BookList) error
+	DeleteBookListByID(ctx context.Context, ID uuid.UUID) error
+	GetPaginatedPublicBookLists(ctx context.Context, pagination *models.BookListPagination) (*models.PaginatedResponse[models.BookList], error)
+	GetPaginatedUserBookLists(ctx context.Context, userID uuid.UUID, pagination *models.BookListPagination) (*models.PaginatedResponse[models.BookList], error)
+	GetRecentPublicBookListsByUserID(ctx context.Context, userID uuid.UUID, limit int) ([]models.BookList, error)
+	GetBookListIDsContainingBook(ctx context.Context, bookListIDs []uuid.UUID, bookID uuid.UUID) ([]uuid.UUID, error)
+	GetBookListItem(ctx context.Context, bookListID, bookID uuid.UUID) (*models.BookListItem, error)
+	AddBookListItem(ctx context.Context, item *models.BookListItem) (bool, error)
+	UpdateBookListItem(ctx context.Context, item *models.BookListItem) error
+	RemoveBookListItem(ctx context.Context, item *models.BookListItem) error
+	ReorderBookList(ctx context.Context, bookListID uuid.UUID, bookIDs []uuid.UUID) error
+}
+
+type bookListRepository struct {
+	di *internal.Di
+	DB *gorm.DB
+}
+
+func NewBookListRepository(di *internal.Di) (BookListRepository, error) {
+	DB, err := internal.Invoke[*gorm.DB](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bookListRepository{
+		di: di,
+		DB: DB,
+	}, nil
+}
+
+func (b *bookListRepository) CreateBookList(ctx context.Context, bookList *models.BookList) error {
+	if err := b.DB.WithContext(ctx).Omit("User", "Items").Create(bookList).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *bookListRepository) GetBookListByID(ctx context.Context, ID uuid.UUID, preloadItems bool) (*models.BookList, error) {
+	query := b.DB.WithContext(ctx).
+		Where("Id = ?", ID).
+		Preload("User")
+
+	if preloadItems {
+		query = query.
+			Preload("Items", func(db *gorm.DB) *gorm.DB {
+				return db.Order("Position ASC")
+			}).
+			Preload("Items.Book").
+			Preload("Items.Book.Authors").
+			Preload("Items.Book.Categories").
+			Preload("Items.Book.Evaluations")
+	}
+
+	var bookList models.BookList
+	if err := query.First(&bookList).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &bookList, nil
+}
+
+func (b *bookListRepository) UpdateBookList(ctx context.Context, bookList *models.BookList) error {
+	if err := b.DB.WithContext(ctx).
+		Model(&models.BookList{}).
+		Where("Id = ?", bookList.ID).
+		Select("Title", "Description", "Visibility", "UpdatedAt").
+		Updates(bookList).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *bookListRepository) DeleteBookListByID(ctx context.Context, ID uuid.UUID) error {
+	err := b.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("BookListId = ?", ID).Delete(&models.BookListItem{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("Id = ?", ID).Delete(&models.BookList{}).Error
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *bookListRepository) GetPaginatedPublicBookLists(ctx context.Context, pagination *models.BookListPagination) (*models.PaginatedResponse[models.BookList], error) {
+	query := b.DB.WithContext(ctx).
+		Model(&models.BookList{}).
+		Where("Visibility = ? AND TotalBooks > 0", models.PublicBookList).
+		Preload("User")
+
+	if pagination.Query != nil {
+		query = query.Where("Title LIKE ?", fmt.Sprintf("%%%s%%", *pagination.Query))
+	}
+
+	if pagination.BookID != nil {
+		query = query.Where("Id IN (?)", b.DB.Model(&models.BookListItem{}).Select("BookListId").Where("BookId = ?", *pagination.BookID))
+	}
+
+	bookLists, err := paginate[models.BookList](query, &pagination.Pagination, &models.BookList{})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return bookLists, nil
+}
+
+func (b *bookListRepository) GetPaginatedUserBookLists(ctx context.Context, userID uuid.UUID, pagination *models.BookListPagination) (*models.PaginatedResponse[models.BookList], error) {
+	query := b.DB.WithContext(ctx).
+		Model(&models.BookList{}).
+		Where("UserId = ?", userID).
+		Preload("User")
+
+	if pagination.Query != nil {
+		query = query.Where("Title LIKE ?", fmt.Sprintf("%%%s%%", *pagination.Query))
+	}
+
+	bookLists, err := paginate[models.BookList](query, &pagination.Pagination, &models.BookList{})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return bookLists, nil
+}
+
+func (b *bookListRepository) GetRecentPublicBookListsByUserID(ctx context.Context, userID uuid.UUID, limit int) ([]models.BookList, error) {
+	var bookLists []models.BookList
+	if err := b.DB.WithContext(ctx).
+		Where("UserId = ? AND Visibility = ?", userID, models.PublicBookList).
+		Preload("User").
+		Order("CreatedAt DESC").
+		Limit(limit).
+		Find(&bookLists).Error; err != nil {
+		return nil, err
+	}
+
+	return bookLists, nil
+}
+
+func (b *bookListRepository) GetBookListIDsContainingBook(ctx context.Context, bookListIDs []uuid.UUID, bookID uuid.UUID) ([]uuid.UUID, error) {
+	if len(bookListIDs) == 0 {
+		return nil, nil
+	}
+
+	var IDs []uuid.UUID
+	if err := b.DB.WithContext(ctx).
+		Model(&models.BookListItem{}).
+		Where("BookListId IN ? AND BookId = ?", bookListIDs, bookID).
+		Pluck("BookListId", &IDs).Error; err != nil {
+		return nil, err
+	}
+
+	return IDs, nil
+}
+
+func (b *bookListRepository) GetBookListItem(ctx context.Context, bookListID, bookID uuid.UUID) (*models.BookListItem, error) {
+	var item models.BookListItem
+	if err := b.DB.WithContext(ctx).
+		Where("BookListId = ? AND BookId = ?", bookListID, bookID).
+		First(&item).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+func (b *bookListRepository) AddBookListItem(ctx context.Context, item *models.BookListItem) (bool, error) {
+	var added bool
+	err := b.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var lastPosition uint
+		if err := tx.Model(&models.BookListItem{}).
+			Where("BookListId = ?", item.BookListID).
+			Select("COALESCE(MAX(Position), 0)").
+			Scan(&lastPosition).Error; err != nil {
+			return err
+		}
+
+		item.Position = lastPosition + 1
+		result := tx.Omit("Book").
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(item)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		added = true
+		return tx.Model(&models.BookList{}).
+			Where("Id = ?", item.BookListID).
+			UpdateColumn("TotalBooks", gorm.Expr("TotalBooks + ?", 1)).Error
+	})
+
+	if err != nil {
+		return false, err
+	}
+
+	return added, nil
+}
+
+func (b *bookListRepository) UpdateBookListItem(ctx context.Context, item *models.BookListItem) error {
+	if err := b.DB.WithContext(ctx).
+		Model(&models.BookListItem{}).
+		Where("Id = ?", item.ID).
+		Select("Note", "UpdatedAt").
+		Updates(item).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *bookListRepository) RemoveBookListItem(ctx context.Context, item *models.BookListItem) error {
+	err := b.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("Id = ?", item.ID).Delete(&models.BookListItem{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.BookListItem{}).
+			Where("BookListId = ? AND Position > ?", item.BookListID, item.Position).
+			UpdateColumn("Position", gorm.Expr("Position - ?", 1)).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&models.BookList{}).
+			Where("Id = ? AND TotalBooks > 0", item.BookListID).
+			UpdateColumn("TotalBooks", gorm.Expr("TotalBooks - ?", 1)).Error
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *bookListRepository) ReorderBookList(ctx context.Context, bookListID uuid.UUID, bookIDs []uuid.UUID) error {
+	err := b.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i, bookID := range bookIDs {
+			if err := tx.Model(&models.BookListItem{}).
+				Where("BookListId = ? AND BookId = ?", bookListID, bookID).
+				UpdateColumn("Position", i+1).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type BookShelfRepository interface {
+	GetBookShelf(ctx context.Context, userID, bookID uuid.UUID) (*models.BookShelf, error)
+	SaveBookShelf(ctx context.Context, bookShelf *models.BookShelf) error
+	DeleteBookShelf(ctx context.Context, userID, bookID uuid.UUID) error
+	CountBooksFinishedBetween(ctx context.Context, userID uuid.UUID, from, to time.Time) (int64, error)
+	GetBooksFinishedBetween(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]models.Book, error)
+}
+
+type bookShelfRepository struct {
+	di *internal.Di
+	DB *gorm.DB
+}
+
+func NewBookShelfRepository(di *internal.Di) (BookShelfRepository, error) {
+	DB, err := internal.Invoke[*gorm.DB](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bookShelfRepository{
+		di: di,
+		DB: DB,
+	}, nil
+}
+
+func (b *bookShelfRepository) GetBookShelf(ctx context.Context, userID, bookID uuid.UUID) (*models.BookShelf, error) {
+	var bookShelf models.BookShelf
+	if err := b.DB.WithContext(ctx).
+		Where("UserId = ? AND BookId = ?", userID, bookID).
+		First(&bookShelf).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &bookShelf, nil
+}
+
+func (b *bookShelfRepository) SaveBookShelf(ctx context.Context, bookShelf *models.BookShelf) error {
+	if err := b.DB.WithContext(ctx).Save(bookShelf).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *bookShelfRepository) DeleteBookShelf(ctx context.Context, userID, bookID uuid.UUID) error {
+	if err := b.DB.WithContext(ctx).
+		Unscoped().
+		Where("UserId = ? AND BookId = ?", userID, bookID).
+		Delete(&models.BookShelf{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *bookShelfRepository) CountBooksFinishedBetween(ctx context.Context, userID uuid.UUID, from, to time.Time) (int64, error) {
+	var total int64
+	if err := b.DB.WithContext(ctx).
+		Model(&models.BookShelf{}).
+		Where("UserId = ? AND Status = ? AND FinishedAt >= ? AND FinishedAt < ?", userID, models.ReadShelf, from, to).
+		Count(&total).Error; err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+func (b *bookShelfRepository) GetBooksFinishedBetween(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]models.Book, error) {
+	var books []models.Book
+	if err := b.DB.WithContext(ctx).
+		Model(&models.Book{}).
+		Joins("JOIN BookShelves ON BookShelves.BookId = Books.Id AND BookShelves.DeletedAt IS NULL").
+		Where("BookShelves.UserId = ? AND BookShelves.Status = ? AND BookShelves.FinishedAt >= ? AND BookShelves.FinishedAt < ?", userID, models.ReadShelf, from, to).
+		Order("BookShelves.FinishedAt ASC").
+		Find(&books).Error; err != nil {
+		return nil, err
+	}
+
+	return books, nil
+}
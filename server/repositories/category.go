@@ -3,9 +3,12 @@ package repositories
 import (
 	"context"
 	"errors"
+	"time"
+	"unicode/utf8"
 
 	"github.com/G-Villarinho/book-wise-api/internal"
 	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
@@ -13,7 +16,19 @@ type CategoryRepository interface {
 	CreateBatch(ctx context.Context, categories []models.Category) error
 	GetCategoriesByNormalizeNames(ctx context.Context, normalizedNames []string) ([]models.Category, error)
 	GetAllCategories(ctx context.Context) ([]models.Category, error)
-	GetTopCategories(ctx context.Context) ([]models.Category, error)
+	GetCategoriesActivity(ctx context.Context, since time.Time, limit int) ([]models.CategoryActivity, error)
+	GetCategoriesByID(ctx context.Context, IDs []uuid.UUID) ([]models.Category, error)
+	CreateCategory(ctx context.Context, category models.Category) error
+	GetCategoryByID(ctx context.Context, ID uuid.UUID) (*models.Category, error)
+	GetCategoryBySlug(ctx context.Context, slug string) (*models.Category, error)
+	GetCategoryByNormalizedName(ctx context.Context, normalizedName string) (*models.Category, error)
+	GetCategoryAliases(ctx context.Context, normalizedNames []string) ([]models.CategoryAlias, error)
+	GetSlugsByPrefix(ctx context.Context, prefix string) ([]string, error)
+	UpdateCategory(ctx context.Context, category models.Category, previousNormalizedName string) error
+	CountChildrenByCategoryID(ctx context.Context, ID uuid.UUID) (int64, error)
+	CountBooksByCategoryID(ctx context.Context, ID uuid.UUID) (int64, error)
+	DeleteCategoryByID(ctx context.Context, ID uuid.UUID) error
+	MergeCategories(ctx context.Context, source models.Category, targetID uuid.UUID) error
 }
 
 type categoryRepository struct {
@@ -35,6 +50,9 @@ func NewCategoryRepository(di *internal.Di) (CategoryRepository, error) {
 
 func (c *categoryRepository) CreateBatch(ctx context.Context, categories []models.Category) error {
 	if err := c.DB.WithContext(ctx).Create(&categories).Error; err != nil {
+		if isDuplicateKeyErrorOn(err, models.CategorySlugIndex) {
+			return models.ErrCategorySlugTaken
+		}
 		return err
 	}
 
@@ -70,20 +88,243 @@ func (c *categoryRepository) GetAllCategories(ctx context.Context) ([]models.Cat
 	return categories, nil
 }
 
-func (c *categoryRepository) GetTopCategories(ctx context.Context) ([]models.Category, error) {
-	var categories []models.Category
+func (c *categoryRepository) GetCategoriesActivity(ctx context.Context, since time.Time, limit int) ([]models.CategoryActivity, error) {
+	var activities []models.CategoryActivity
 
 	if err := c.DB.WithContext(ctx).
-		Table("Categories").
-		Select("Categories.*, SUM(Books.TotalEvaluations) as TotalEvaluations").
-		Joins("JOIN BookCategories ON BookCategories.CategoryID = Categories.Id").
-		Joins("JOIN Books ON Books.Id = BookCategories.BookID").
-		Group("Categories.Id").
-		Order("SUM(Books.TotalEvaluations) DESC").
-		Limit(7).
-		Find(&categories).Error; err != nil {
+		Table("Evaluations").
+		Select("BookCategories.CategoryID AS CategoryId, COUNT(Evaluations.Id) AS TotalEvaluations, COUNT(DISTINCT Books.Id) AS TotalBooks").
+		Joins("JOIN Books ON Books.Id = Evaluations.BookId").
+		Joins("JOIN BookCategories ON BookCategories.BookID = Books.Id").
+		Where("Evaluations.CreatedAt >= ?", since).
+		Where("Evaluations.DeletedAt IS NULL AND Evaluations.Status = ?", models.VisibleEvaluation).
+		Where("Books.Published = ? AND Books.DeletedAt IS NULL", true).
+		Group("BookCategories.CategoryID").
+		Order("TotalEvaluations DESC").
+		Limit(limit).
+		Scan(&activities).Error; err != nil {
+		return nil, err
+	}
+
+	return activities, nil
+}
+
+func (c *categoryRepository) GetCategoriesByID(ctx context.Context, IDs []uuid.UUID) ([]models.Category, error) {
+	var categories []models.Category
+
+	if err := c.DB.WithContext(ctx).Where("Id IN ?", IDs).Find(&categories).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
 		return nil, err
 	}
 
 	return categories, nil
 }
+
+func (c *categoryRepository) CreateCategory(ctx context.Context, category models.Category) error {
+	if err := c.DB.WithContext(ctx).Create(&category).Error; err != nil {
+		if isDuplicateKeyErrorOn(err, models.CategorySlugIndex) {
+			return models.ErrCategorySlugTaken
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (c *categoryRepository) GetCategoryByID(ctx context.Context, ID uuid.UUID) (*models.Category, error) {
+	var category models.Category
+	if err := c.DB.WithContext(ctx).Where("Id = ?", ID).First(&category).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &category, nil
+}
+
+func (c *categoryRepository) GetCategoryBySlug(ctx context.Context, slug string) (*models.Category, error) {
+	var category models.Category
+	if err := c.DB.WithContext(ctx).Where("Slug = ?", slug).First(&category).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &category, nil
+}
+
+func (c *categoryRepository) GetCategoryByNormalizedName(ctx context.Context, normalizedName string) (*models.Category, error) {
+	var category models.Category
+	if err := c.DB.WithContext(ctx).Where("NormalizedName = ?", normalizedName).First(&category).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &category, nil
+}
+
+func (c *categoryRepository) GetCategoryAliases(ctx context.Context, normalizedNames []string) ([]models.CategoryAlias, error) {
+	var aliases []models.CategoryAlias
+	if err := c.DB.WithContext(ctx).
+		Preload("Category").
+		Where("NormalizedName IN ?", normalizedNames).
+		Find(&aliases).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return aliases, nil
+}
+
+func (c *categoryRepository) GetSlugsByPrefix(ctx context.Context, prefix string) ([]string, error) {
+	var slugs []string
+	if err := c.DB.WithContext(ctx).
+		Model(&models.Category{}).
+		Unscoped().
+		Where("Slug = ? OR Slug LIKE ?", prefix, prefix+"-%").
+		Pluck("Slug", &slugs).Error; err != nil {
+		return nil, err
+	}
+
+	return slugs, nil
+}
+
+func (c *categoryRepository) UpdateCategory(ctx context.Context, category models.Category, previousNormalizedName string) error {
+	err := c.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Category{}).
+			Where("Id = ?", category.ID).
+			Updates(map[string]any{
+				"Name":           category.Name,
+				"NormalizedName": category.NormalizedName,
+				"ParentId":       category.ParentID,
+				"Translations":   category.Translations,
+			}).Error; err != nil {
+			return err
+		}
+
+		if previousNormalizedName == category.NormalizedName {
+			return nil
+		}
+
+		oldPrefix := previousNormalizedName + models.CategoryPathSeparator
+		if err := tx.Exec(
+			"UPDATE Categories SET NormalizedName = CONCAT(?, SUBSTRING(NormalizedName, ?)) WHERE NormalizedName LIKE ?",
+			category.NormalizedName+models.CategoryPathSeparator,
+			utf8.RuneCountInString(oldPrefix)+1,
+			oldPrefix+"%",
+		).Error; err != nil {
+			return err
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *categoryRepository) CountChildrenByCategoryID(ctx context.Context, ID uuid.UUID) (int64, error) {
+	var total int64
+	if err := c.DB.WithContext(ctx).Model(&models.Category{}).Where("ParentId = ?", ID).Count(&total).Error; err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+func (c *categoryRepository) CountBooksByCategoryID(ctx context.Context, ID uuid.UUID) (int64, error) {
+	var total int64
+	if err := c.DB.WithContext(ctx).
+		Table("BookCategories").
+		Joins("JOIN Books ON Books.Id = BookCategories.BookID").
+		Where("BookCategories.CategoryID = ? AND Books.DeletedAt IS NULL", ID).
+		Count(&total).Error; err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+func (c *categoryRepository) DeleteCategoryByID(ctx context.Context, ID uuid.UUID) error {
+	err := c.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM BookCategories WHERE CategoryID = ?", ID).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("CategoryId = ?", ID).Delete(&models.CategoryAlias{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Where("Id = ?", ID).Delete(&models.Category{}).Error; err != nil {
+			return err
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *categoryRepository) MergeCategories(ctx context.Context, source models.Category, targetID uuid.UUID) error {
+	err := c.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`
+			UPDATE BookCategories SET CategoryID = ?
+			WHERE CategoryID = ?
+			AND BookID NOT IN (
+				SELECT BookID FROM (SELECT BookID FROM BookCategories WHERE CategoryID = ?) AS TargetBooks
+			)`, targetID, source.ID, targetID).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Exec("DELETE FROM BookCategories WHERE CategoryID = ?", source.ID).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.CategoryAlias{}).
+			Where("CategoryId = ?", source.ID).
+			Update("CategoryId", targetID).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Where("Id = ?", source.ID).Delete(&models.Category{}).Error; err != nil {
+			return err
+		}
+
+		alias := models.CategoryAlias{
+			NormalizedName: source.NormalizedName,
+			CategoryID:     targetID,
+		}
+
+		if err := tx.Create(&alias).Error; err != nil {
+			return err
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
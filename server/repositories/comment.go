@@ -0,0 +1,102 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type CommentRepository interface {
+	CreateComment(ctx context.Context, comment *models.Comment) error
+	GetCommentByID(ctx context.Context, ID uuid.UUID) (*models.Comment, error)
+	GetPaginatedCommentsByEvaluationID(ctx context.Context, evaluationID uuid.UUID, pagination *models.Pagination) (*models.PaginatedResponse[models.Comment], error)
+	UpdateComment(ctx context.Context, comment *models.Comment) error
+	DeleteCommentByID(ctx context.Context, ID uuid.UUID) error
+}
+
+type commentRepository struct {
+	di *internal.Di
+	DB *gorm.DB
+}
+
+func NewCommentRepository(di *internal.Di) (CommentRepository, error) {
+	DB, err := internal.Invoke[*gorm.DB](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &commentRepository{
+		di: di,
+		DB: DB,
+	}, nil
+}
+
+func (c *commentRepository) CreateComment(ctx context.Context, comment *models.Comment) error {
+	if err := c.DB.WithContext(ctx).Omit("User", "Evaluation", "Replies").Create(comment).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *commentRepository) GetCommentByID(ctx context.Context, ID uuid.UUID) (*models.Comment, error) {
+	var comment models.Comment
+	if err := c.DB.WithContext(ctx).
+		Where("Id = ?", ID).
+		Preload("User").
+		First(&comment).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &comment, nil
+}
+
+func (c *commentRepository) GetPaginatedCommentsByEvaluationID(ctx context.Context, evaluationID uuid.UUID, pagination *models.Pagination) (*models.PaginatedResponse[models.Comment], error) {
+	query := c.DB.WithContext(ctx).
+		Model(&models.Comment{}).
+		Where("EvaluationId = ? AND ParentId IS NULL", evaluationID).
+		Preload("User").
+		Preload("Replies", func(db *gorm.DB) *gorm.DB {
+			return db.Order("CreatedAt ASC")
+		}).
+		Preload("Replies.User")
+
+	comments, err := paginate[models.Comment](query, pagination, &models.Comment{})
+	if err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+func (c *commentRepository) UpdateComment(ctx context.Context, comment *models.Comment) error {
+	if err := c.DB.WithContext(ctx).
+		Model(&models.Comment{}).
+		Where("Id = ?", comment.ID).
+		Updates(map[string]any{
+			"Content":   comment.Content,
+			"UpdatedAt": comment.UpdatedAt,
+		}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *commentRepository) DeleteCommentByID(ctx context.Context, ID uuid.UUID) error {
+	if err := c.DB.WithContext(ctx).
+		Where("Id = ? OR ParentId = ?", ID, ID).
+		Delete(&models.Comment{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
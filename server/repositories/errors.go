@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+const mysqlDuplicateEntryErrorNumber = 1062
+
+// isDuplicateKeyError reports whether the write was rejected by a unique index,
+// e.g. when two requests race for the same value after checking it was free.
+func isDuplicateKeyError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlDuplicateEntryErrorNumber
+}
+
+// isDuplicateKeyErrorOn reports whether the write was rejected by the given
+// unique index, for tables that have more than one.
+func isDuplicateKeyErrorOn(err error, index string) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlDuplicateEntryErrorNumber && strings.Contains(mysqlErr.Message, index)
+}
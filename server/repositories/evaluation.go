@@ -8,12 +8,22 @@ import (
 	"github.com/G-Villarinho/book-wise-api/models"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type EvaluationRepository interface {
 	CreateEvaluation(ctx context.Context, evaluation models.Evaluation) error
 	GetUserEvaluationForBook(ctx context.Context, userID, bookID uuid.UUID) (*models.Evaluation, error)
 	GetPaginatedEvaluationsByBookID(ctx context.Context, userID, bookID uuid.UUID, pagination *models.Pagination) (*models.PaginatedResponse[models.Evaluation], error)
+	GetRatingBreakdown(ctx context.Context, bookID uuid.UUID) ([]models.RatingCount, error)
+	GetEvaluationByID(ctx context.Context, ID uuid.UUID) (*models.Evaluation, error)
+	LikeEvaluation(ctx context.Context, like *models.EvaluationLike) error
+	UnlikeEvaluation(ctx context.Context, evaluationID, userID uuid.UUID) error
+	GetLikedEvaluationIDs(ctx context.Context, userID uuid.UUID, evaluationIDs []uuid.UUID) ([]uuid.UUID, error)
+	UpdateEvaluationStatus(ctx context.Context, evaluation *models.Evaluation, status models.EvaluationStatus) error
+	DeleteEvaluation(ctx context.Context, evaluation *models.Evaluation) error
+	GetUserRatingSummary(ctx context.Context, userID uuid.UUID) (*models.RatingSummary, error)
+	GetRecentUserEvaluations(ctx context.Context, userID uuid.UUID, limit int) ([]models.Evaluation, error)
 }
 
 type evaluationRepository struct {
@@ -49,11 +59,13 @@ func (e *evaluationRepository) CreateEvaluation(ctx context.Context, evaluation
 		return err
 	}
 
-	if err := tx.Model(&models.Book{}).
-		Where("ID = ?", evaluation.BookID).
-		UpdateColumn("TotalEvaluations", gorm.Expr("TotalEvaluations + ?", 1)).Error; err != nil {
-		tx.Rollback()
-		return err
+	if evaluation.Status == models.VisibleEvaluation {
+		if err := tx.Model(&models.Book{}).
+			Where("ID = ?", evaluation.BookID).
+			UpdateColumn("TotalEvaluations", gorm.Expr("TotalEvaluations + ?", 1)).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
 	}
 
 	if err := tx.Commit().Error; err != nil {
@@ -67,6 +79,7 @@ func (e *evaluationRepository) GetUserEvaluationForBook(ctx context.Context, use
 	var evaluation models.Evaluation
 	if err := e.DB.WithContext(ctx).
 		Where("UserId = ? AND BookId = ?", userID, bookID).
+		Preload("User").
 		First(&evaluation).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, nil
@@ -82,12 +95,12 @@ func (e *evaluationRepository) GetPaginatedEvaluationsByBookID(ctx context.Conte
 	query := e.DB.
 		WithContext(ctx).
 		Model(&models.Evaluation{}).
-		Where("BookId = ?", bookID).
+		Where("BookId = ? AND Status = ?", bookID, models.VisibleEvaluation).
 		Preload("User")
 
 	userEvaluationSubquery := e.DB.WithContext(ctx).
 		Model(&models.Evaluation{}).
-		Where("UserId = ? AND BookId = ?", userID, bookID).
+		Where("UserId = ? AND BookId = ? AND Status = ?", userID, bookID, models.VisibleEvaluation).
 		Preload("User").
 		Limit(1)
 
@@ -100,8 +113,6 @@ func (e *evaluationRepository) GetPaginatedEvaluationsByBookID(ctx context.Conte
 		query = query.Where("Id != ?", userEvaluation.ID)
 	}
 
-	query = query.Order("createdAt DESC")
-
 	evaluations, err := paginate[models.Evaluation](query, pagination, &models.Evaluation{})
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -117,3 +128,200 @@ func (e *evaluationRepository) GetPaginatedEvaluationsByBookID(ctx context.Conte
 
 	return evaluations, nil
 }
+
+func (e *evaluationRepository) GetRatingBreakdown(ctx context.Context, bookID uuid.UUID) ([]models.RatingCount, error) {
+	var ratings []models.RatingCount
+	if err := e.DB.WithContext(ctx).
+		Model(&models.Evaluation{}).
+		Select("Rate, COUNT(*) AS Total").
+		Where("BookId = ? AND Status = ?", bookID, models.VisibleEvaluation).
+		Group("Rate").
+		Scan(&ratings).Error; err != nil {
+		return nil, err
+	}
+
+	return ratings, nil
+}
+
+func (e *evaluationRepository) GetEvaluationByID(ctx context.Context, ID uuid.UUID) (*models.Evaluation, error) {
+	var evaluation models.Evaluation
+	if err := e.DB.WithContext(ctx).
+		Where("Id = ?", ID).
+		First(&evaluation).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &evaluation, nil
+}
+
+func (e *evaluationRepository) LikeEvaluation(ctx context.Context, like *models.EvaluationLike) error {
+	err := e.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Omit("Evaluation", "User").
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(like)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		return tx.Model(&models.Evaluation{}).
+			Where("Id = ?", like.EvaluationID).
+			UpdateColumn("Likes", gorm.Expr("Likes + ?", 1)).Error
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (e *evaluationRepository) UnlikeEvaluation(ctx context.Context, evaluationID, userID uuid.UUID) error {
+	err := e.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().
+			Where("EvaluationId = ? AND UserId = ?", evaluationID, userID).
+			Delete(&models.EvaluationLike{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		return tx.Model(&models.Evaluation{}).
+			Where("Id = ? AND Likes > 0", evaluationID).
+			UpdateColumn("Likes", gorm.Expr("Likes - ?", 1)).Error
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (e *evaluationRepository) GetLikedEvaluationIDs(ctx context.Context, userID uuid.UUID, evaluationIDs []uuid.UUID) ([]uuid.UUID, error) {
+	if len(evaluationIDs) == 0 {
+		return nil, nil
+	}
+
+	var likedIDs []uuid.UUID
+	if err := e.DB.WithContext(ctx).
+		Model(&models.EvaluationLike{}).
+		Where("UserId = ? AND EvaluationId IN ?", userID, evaluationIDs).
+		Pluck("EvaluationId", &likedIDs).Error; err != nil {
+		return nil, err
+	}
+
+	return likedIDs, nil
+}
+
+func (e *evaluationRepository) UpdateEvaluationStatus(ctx context.Context, evaluation *models.Evaluation, status models.EvaluationStatus) error {
+	err := e.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Only the request that actually moves the evaluation out of the status
+		// it was read with adjusts the counter, so concurrent moderators don't
+		// apply the same delta twice.
+		result := tx.Model(&models.Evaluation{}).
+			Where("Id = ? AND Status = ?", evaluation.ID, evaluation.Status).
+			UpdateColumn("Status", status)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected != 1 {
+			return nil
+		}
+
+		delta := totalEvaluationsDelta(evaluation.Status, status)
+		if delta > 0 {
+			return tx.Model(&models.Book{}).
+				Where("Id = ?", evaluation.BookID).
+				UpdateColumn("TotalEvaluations", gorm.Expr("TotalEvaluations + ?", delta)).Error
+		}
+
+		if delta < 0 {
+			return tx.Model(&models.Book{}).
+				Where("Id = ? AND TotalEvaluations > 0", evaluation.BookID).
+				UpdateColumn("TotalEvaluations", gorm.Expr("TotalEvaluations - ?", -delta)).Error
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (e *evaluationRepository) DeleteEvaluation(ctx context.Context, evaluation *models.Evaluation) error {
+	err := e.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("Id = ?", evaluation.ID).Delete(&models.Evaluation{}).Error; err != nil {
+			return err
+		}
+
+		if evaluation.Status != models.VisibleEvaluation {
+			return nil
+		}
+
+		return tx.Model(&models.Book{}).
+			Where("Id = ? AND TotalEvaluations > 0", evaluation.BookID).
+			UpdateColumn("TotalEvaluations", gorm.Expr("TotalEvaluations - ?", 1)).Error
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (e *evaluationRepository) GetUserRatingSummary(ctx context.Context, userID uuid.UUID) (*models.RatingSummary, error) {
+	var summary models.RatingSummary
+	if err := e.DB.WithContext(ctx).
+		Model(&models.Evaluation{}).
+		Select("COALESCE(AVG(Rate), 0) AS Average, COUNT(*) AS Total").
+		Where("UserId = ?", userID).
+		Scan(&summary).Error; err != nil {
+		return nil, err
+	}
+
+	return &summary, nil
+}
+
+func (e *evaluationRepository) GetRecentUserEvaluations(ctx context.Context, userID uuid.UUID, limit int) ([]models.Evaluation, error) {
+	var evaluations []models.Evaluation
+	if err := e.DB.WithContext(ctx).
+		Joins("JOIN Books ON Books.Id = Evaluations.BookId AND Books.Published = ? AND Books.DeletedAt IS NULL", true).
+		Where("Evaluations.UserId = ? AND Evaluations.Status = ?", userID, models.VisibleEvaluation).
+		Preload("User").
+		Preload("Book").
+		Preload("Book.Authors").
+		Order("Evaluations.CreatedAt DESC").
+		Limit(limit).
+		Find(&evaluations).Error; err != nil {
+		return nil, err
+	}
+
+	return evaluations, nil
+}
+
+func totalEvaluationsDelta(from, to models.EvaluationStatus) int {
+	switch {
+	case from != models.VisibleEvaluation && to == models.VisibleEvaluation:
+		return 1
+	case from == models.VisibleEvaluation && to != models.VisibleEvaluation:
+		return -1
+	default:
+		return 0
+	}
+}
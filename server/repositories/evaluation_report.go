@@ -0,0 +1,89 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type EvaluationReportRepository interface {
+	CreateEvaluationReport(ctx context.Context, report *models.EvaluationReport) (bool, error)
+	GetPaginatedPendingModeration(ctx context.Context, pagination *models.Pagination) (*models.PaginatedResponse[models.Evaluation], error)
+	ResolveEvaluationReports(ctx context.Context, evaluationID, moderatorID uuid.UUID, action models.ModerationAction) error
+}
+
+type evaluationReportRepository struct {
+	di *internal.Di
+	DB *gorm.DB
+}
+
+func NewEvaluationReportRepository(di *internal.Di) (EvaluationReportRepository, error) {
+	DB, err := internal.Invoke[*gorm.DB](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &evaluationReportRepository{
+		di: di,
+		DB: DB,
+	}, nil
+}
+
+func (e *evaluationReportRepository) CreateEvaluationReport(ctx context.Context, report *models.EvaluationReport) (bool, error) {
+	result := e.DB.WithContext(ctx).
+		Omit("User").
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(report)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (e *evaluationReportRepository) GetPaginatedPendingModeration(ctx context.Context, pagination *models.Pagination) (*models.PaginatedResponse[models.Evaluation], error) {
+	openReports := e.DB.
+		Model(&models.EvaluationReport{}).
+		Select("EvaluationId").
+		Where("Status = ?", models.OpenReport)
+
+	query := e.DB.WithContext(ctx).
+		Model(&models.Evaluation{}).
+		Where("Id IN (?) OR Status = ?", openReports, models.PendingEvaluation).
+		Preload("User").
+		Preload("Book").
+		Preload("Reports", "Status = ?", models.OpenReport)
+
+	evaluations, err := paginate[models.Evaluation](query, pagination, &models.Evaluation{})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return evaluations, nil
+}
+
+func (e *evaluationReportRepository) ResolveEvaluationReports(ctx context.Context, evaluationID, moderatorID uuid.UUID, action models.ModerationAction) error {
+	if err := e.DB.WithContext(ctx).
+		Model(&models.EvaluationReport{}).
+		Where("EvaluationId = ? AND Status = ?", evaluationID, models.OpenReport).
+		Updates(map[string]any{
+			"Status":     models.ResolvedReport,
+			"Resolution": action,
+			"ResolvedBy": moderatorID,
+			"ResolvedAt": time.Now().UTC(),
+		}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
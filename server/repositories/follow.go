@@ -0,0 +1,149 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type FollowRepository interface {
+	GetFollow(ctx context.Context, userID uuid.UUID, targetType models.FollowTargetType, targetID uuid.UUID) (*models.Follow, error)
+	SaveFollow(ctx context.Context, follow *models.Follow) error
+	DeleteFollow(ctx context.Context, userID uuid.UUID, targetType models.FollowTargetType, targetID uuid.UUID) error
+	GetBookFollowers(ctx context.Context, bookID uuid.UUID) ([]models.BookFollower, error)
+	GetFollowerIDs(ctx context.Context, targetType models.FollowTargetType, targetID uuid.UUID) ([]uuid.UUID, error)
+	ReassignFollows(ctx context.Context, targetType models.FollowTargetType, sourceID, targetID uuid.UUID) error
+	DeleteFollowsByTarget(ctx context.Context, targetType models.FollowTargetType, targetID uuid.UUID) error
+}
+
+type followRepository struct {
+	di *internal.Di
+	DB *gorm.DB
+}
+
+func NewFollowRepository(di *internal.Di) (FollowRepository, error) {
+	DB, err := internal.Invoke[*gorm.DB](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &followRepository{
+		di: di,
+		DB: DB,
+	}, nil
+}
+
+func (f *followRepository) GetFollow(ctx context.Context, userID uuid.UUID, targetType models.FollowTargetType, targetID uuid.UUID) (*models.Follow, error) {
+	var follow models.Follow
+	if err := f.DB.WithContext(ctx).
+		Where("UserId = ? AND TargetType = ? AND TargetId = ?", userID, targetType, targetID).
+		First(&follow).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &follow, nil
+}
+
+func (f *followRepository) SaveFollow(ctx context.Context, follow *models.Follow) error {
+	if err := f.DB.WithContext(ctx).Save(follow).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (f *followRepository) DeleteFollow(ctx context.Context, userID uuid.UUID, targetType models.FollowTargetType, targetID uuid.UUID) error {
+	if err := f.DB.WithContext(ctx).
+		Unscoped().
+		Where("UserId = ? AND TargetType = ? AND TargetId = ?", userID, targetType, targetID).
+		Delete(&models.Follow{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (f *followRepository) GetBookFollowers(ctx context.Context, bookID uuid.UUID) ([]models.BookFollower, error) {
+	authorIDs := f.DB.
+		Table("BookAuthors").
+		Select("AuthorID").
+		Where("BookID = ?", bookID)
+
+	categoryIDs := f.DB.
+		Model(&models.Category{}).
+		Select("Categories.Id").
+		Joins("JOIN Categories AS BookCategory ON BookCategory.NormalizedName = Categories.NormalizedName OR BookCategory.NormalizedName LIKE CONCAT(Categories.NormalizedName, ?)", models.CategoryPathSeparator+"%").
+		Joins("JOIN BookCategories ON BookCategories.CategoryID = BookCategory.Id").
+		Where("BookCategories.BookID = ?", bookID)
+
+	var followers []models.BookFollower
+	if err := f.DB.WithContext(ctx).
+		Model(&models.Follow{}).
+		Select("UserId, MAX(NotifyByEmail) AS NotifyByEmail").
+		Where("(TargetType = ? AND TargetId IN (?)) OR (TargetType = ? AND TargetId IN (?))",
+			models.AuthorFollowTarget, authorIDs,
+			models.CategoryFollowTarget, categoryIDs,
+		).
+		Group("UserId").
+		Scan(&followers).Error; err != nil {
+		return nil, err
+	}
+
+	return followers, nil
+}
+
+func (f *followRepository) GetFollowerIDs(ctx context.Context, targetType models.FollowTargetType, targetID uuid.UUID) ([]uuid.UUID, error) {
+	var followerIDs []uuid.UUID
+	if err := f.DB.WithContext(ctx).
+		Model(&models.Follow{}).
+		Where("TargetType = ? AND TargetId = ?", targetType, targetID).
+		Pluck("UserId", &followerIDs).Error; err != nil {
+		return nil, err
+	}
+
+	return followerIDs, nil
+}
+
+func (f *followRepository) ReassignFollows(ctx context.Context, targetType models.FollowTargetType, sourceID, targetID uuid.UUID) error {
+	err := f.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`
+			UPDATE Follows SET TargetId = ?
+			WHERE TargetType = ? AND TargetId = ?
+			AND UserId NOT IN (
+				SELECT UserId FROM (SELECT UserId FROM Follows WHERE TargetType = ? AND TargetId = ?) AS TargetFollowers
+			)`, targetID, targetType, sourceID, targetType, targetID).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Exec("DELETE FROM Follows WHERE TargetType = ? AND TargetId = ?", targetType, sourceID).Error; err != nil {
+			return err
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (f *followRepository) DeleteFollowsByTarget(ctx context.Context, targetType models.FollowTargetType, targetID uuid.UUID) error {
+	if err := f.DB.WithContext(ctx).
+		Unscoped().
+		Where("TargetType = ? AND TargetId = ?", targetType, targetID).
+		Delete(&models.Follow{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const imageReferencesBatchSize = 500
+
+type ImageRepository interface {
+	GetReferencedImageIDs(ctx context.Context) (map[uuid.UUID]struct{}, error)
+}
+
+type imageRepository struct {
+	di *internal.Di
+	DB *gorm.DB
+}
+
+func NewImageRepository(di *internal.Di) (ImageRepository, error) {
+	DB, err := internal.Invoke[*gorm.DB](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &imageRepository{
+		di: di,
+		DB: DB,
+	}, nil
+}
+
+func (i *imageRepository) GetReferencedImageIDs(ctx context.Context) (map[uuid.UUID]struct{}, error) {
+	referenced := make(map[uuid.UUID]struct{})
+
+	var authors []models.Author
+	if err := i.DB.WithContext(ctx).
+		Select("Id", "AvatarImageClientId", "AvatarVariants").
+		FindInBatches(&authors, imageReferencesBatchSize, func(tx *gorm.DB, batch int) error {
+			for _, author := range authors {
+				addImageIDs(referenced, author.AvatarImageIDs())
+			}
+			return nil
+		}).Error; err != nil {
+		return nil, err
+	}
+
+	var users []models.User
+	if err := i.DB.WithContext(ctx).
+		Select("Id", "AvatarImageClientId", "AvatarVariants").
+		FindInBatches(&users, imageReferencesBatchSize, func(tx *gorm.DB, batch int) error {
+			for _, user := range users {
+				addImageIDs(referenced, user.AvatarImageIDs())
+			}
+			return nil
+		}).Error; err != nil {
+		return nil, err
+	}
+
+	var books []models.Book
+	if err := i.DB.WithContext(ctx).
+		Select("Id", "CoverImageClientId", "CoverVariants").
+		FindInBatches(&books, imageReferencesBatchSize, func(tx *gorm.DB, batch int) error {
+			for _, book := range books {
+				addImageIDs(referenced, book.CoverImageIDs())
+			}
+			return nil
+		}).Error; err != nil {
+		return nil, err
+	}
+
+	return referenced, nil
+}
+
+func addImageIDs(referenced map[uuid.UUID]struct{}, IDs []uuid.UUID) {
+	for _, ID := range IDs {
+		referenced[ID] = struct{}{}
+	}
+}
@@ -0,0 +1,152 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type NotificationRepository interface {
+	CreateNotifications(ctx context.Context, notifications []models.Notification) (int64, error)
+	GetNotificationByID(ctx context.Context, ID uuid.UUID) (*models.Notification, error)
+	GetPaginatedNotificationsByUserID(ctx context.Context, userID uuid.UUID, pagination *models.NotificationPagination) (*models.PaginatedResponse[models.Notification], error)
+	CountUnreadNotifications(ctx context.Context, userID uuid.UUID) (int64, error)
+	MarkNotificationAsRead(ctx context.Context, ID uuid.UUID, readAt time.Time) error
+	MarkAllNotificationsAsRead(ctx context.Context, userID uuid.UUID, readAt time.Time) error
+	GetPendingEmailNotifications(ctx context.Context) ([]models.Notification, error)
+	MarkNotificationsEmailed(ctx context.Context, IDs []uuid.UUID, emailedAt time.Time) error
+}
+
+type notificationRepository struct {
+	di *internal.Di
+	DB *gorm.DB
+}
+
+func NewNotificationRepository(di *internal.Di) (NotificationRepository, error) {
+	DB, err := internal.Invoke[*gorm.DB](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &notificationRepository{
+		di: di,
+		DB: DB,
+	}, nil
+}
+
+func (n *notificationRepository) CreateNotifications(ctx context.Context, notifications []models.Notification) (int64, error) {
+	if len(notifications) == 0 {
+		return 0, nil
+	}
+
+	result := n.DB.WithContext(ctx).
+		Omit("User", "Book").
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&notifications)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+func (n *notificationRepository) GetNotificationByID(ctx context.Context, ID uuid.UUID) (*models.Notification, error) {
+	var notification models.Notification
+	if err := n.DB.WithContext(ctx).
+		Where("Id = ?", ID).
+		First(&notification).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &notification, nil
+}
+
+func (n *notificationRepository) GetPaginatedNotificationsByUserID(ctx context.Context, userID uuid.UUID, pagination *models.NotificationPagination) (*models.PaginatedResponse[models.Notification], error) {
+	query := n.DB.WithContext(ctx).
+		Model(&models.Notification{}).
+		Where("UserId = ?", userID)
+
+	if pagination.Unread {
+		query = query.Where("ReadAt IS NULL")
+	}
+
+	notifications, err := paginate[models.Notification](query, &pagination.Pagination, &models.Notification{})
+	if err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+func (n *notificationRepository) CountUnreadNotifications(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var total int64
+	if err := n.DB.WithContext(ctx).
+		Model(&models.Notification{}).
+		Where("UserId = ? AND ReadAt IS NULL", userID).
+		Count(&total).Error; err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+func (n *notificationRepository) MarkNotificationAsRead(ctx context.Context, ID uuid.UUID, readAt time.Time) error {
+	if err := n.DB.WithContext(ctx).
+		Model(&models.Notification{}).
+		Where("Id = ? AND ReadAt IS NULL", ID).
+		Update("ReadAt", readAt).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (n *notificationRepository) MarkAllNotificationsAsRead(ctx context.Context, userID uuid.UUID, readAt time.Time) error {
+	if err := n.DB.WithContext(ctx).
+		Model(&models.Notification{}).
+		Where("UserId = ? AND ReadAt IS NULL", userID).
+		Update("ReadAt", readAt).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (n *notificationRepository) GetPendingEmailNotifications(ctx context.Context) ([]models.Notification, error) {
+	var notifications []models.Notification
+	if err := n.DB.WithContext(ctx).
+		Where("SendEmail = ? AND EmailedAt IS NULL", true).
+		Preload("User").
+		Preload("Book").
+		Order("CreatedAt ASC").
+		Find(&notifications).Error; err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+func (n *notificationRepository) MarkNotificationsEmailed(ctx context.Context, IDs []uuid.UUID, emailedAt time.Time) error {
+	if len(IDs) == 0 {
+		return nil
+	}
+
+	if err := n.DB.WithContext(ctx).
+		Model(&models.Notification{}).
+		Where("Id IN ?", IDs).
+		Update("EmailedAt", emailedAt).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ReadingChallengeRepository interface {
+	GetReadingChallenge(ctx context.Context, userID uuid.UUID, year int) (*models.ReadingChallenge, error)
+	SaveReadingChallenge(ctx context.Context, challenge *models.ReadingChallenge) error
+	GetPendingChallengeSummaries(ctx context.Context, beforeYear int) ([]models.ReadingChallenge, error)
+	MarkChallengeSummarySent(ctx context.Context, ID uuid.UUID, sentAt time.Time) error
+}
+
+type readingChallengeRepository struct {
+	di *internal.Di
+	DB *gorm.DB
+}
+
+func NewReadingChallengeRepository(di *internal.Di) (ReadingChallengeRepository, error) {
+	DB, err := internal.Invoke[*gorm.DB](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &readingChallengeRepository{
+		di: di,
+		DB: DB,
+	}, nil
+}
+
+func (r *readingChallengeRepository) GetReadingChallenge(ctx context.Context, userID uuid.UUID, year int) (*models.ReadingChallenge, error) {
+	var challenge models.ReadingChallenge
+	if err := r.DB.WithContext(ctx).
+		Where("UserId = ? AND Year = ?", userID, year).
+		First(&challenge).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &challenge, nil
+}
+
+func (r *readingChallengeRepository) SaveReadingChallenge(ctx context.Context, challenge *models.ReadingChallenge) error {
+	if err := r.DB.WithContext(ctx).Omit("User").Save(challenge).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *readingChallengeRepository) GetPendingChallengeSummaries(ctx context.Context, beforeYear int) ([]models.ReadingChallenge, error) {
+	var challenges []models.ReadingChallenge
+	if err := r.DB.WithContext(ctx).
+		Where("Year < ? AND SummarySentAt IS NULL", beforeYear).
+		Preload("User").
+		Order("Year ASC").
+		Find(&challenges).Error; err != nil {
+		return nil, err
+	}
+
+	return challenges, nil
+}
+
+func (r *readingChallengeRepository) MarkChallengeSummarySent(ctx context.Context, ID uuid.UUID, sentAt time.Time) error {
+	if err := r.DB.WithContext(ctx).
+		Model(&models.ReadingChallenge{}).
+		Where("Id = ?", ID).
+		UpdateColumn("SummarySentAt", sentAt).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
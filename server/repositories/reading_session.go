@@ -0,0 +1,174 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ReadingSessionRepository interface {
+	CreateReadingSession(ctx context.Context, readingSession *models.ReadingSession) error
+	GetReadingSessionByID(ctx context.Context, ID uuid.UUID) (*models.ReadingSession, error)
+	GetPaginatedReadingSessions(ctx context.Context, userID, bookID uuid.UUID, pagination *models.Pagination) (*models.PaginatedResponse[models.ReadingSession], error)
+	DeleteReadingSessionByID(ctx context.Context, ID uuid.UUID) error
+	GetReadingProgress(ctx context.Context, userID, bookID uuid.UUID) (*models.ReadingProgress, error)
+	GetReadingTotals(ctx context.Context, userID uuid.UUID) (*models.ReadingTotals, error)
+	GetPagesPerWeek(ctx context.Context, userID uuid.UUID, since time.Time) ([]models.PeriodCount, error)
+	GetBooksFinishedPerMonth(ctx context.Context, userID uuid.UUID, since time.Time) ([]models.PeriodCount, error)
+	CountFinishedBooks(ctx context.Context, userID uuid.UUID) (int64, error)
+	GetFavoriteCategories(ctx context.Context, userID uuid.UUID, limit int) ([]models.FavoriteCategory, error)
+}
+
+type readingSessionRepository struct {
+	di *internal.Di
+	DB *gorm.DB
+}
+
+func NewReadingSessionRepository(di *internal.Di) (ReadingSessionRepository, error) {
+	DB, err := internal.Invoke[*gorm.DB](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &readingSessionRepository{
+		di: di,
+		DB: DB,
+	}, nil
+}
+
+func (r *readingSessionRepository) CreateReadingSession(ctx context.Context, readingSession *models.ReadingSession) error {
+	if err := r.DB.WithContext(ctx).Omit("User", "Book").Create(readingSession).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *readingSessionRepository) GetReadingSessionByID(ctx context.Context, ID uuid.UUID) (*models.ReadingSession, error) {
+	var readingSession models.ReadingSession
+	if err := r.DB.WithContext(ctx).
+		Where("Id = ?", ID).
+		First(&readingSession).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &readingSession, nil
+}
+
+func (r *readingSessionRepository) GetPaginatedReadingSessions(ctx context.Context, userID, bookID uuid.UUID, pagination *models.Pagination) (*models.PaginatedResponse[models.ReadingSession], error) {
+	query := r.DB.WithContext(ctx).
+		Model(&models.ReadingSession{}).
+		Where("UserId = ? AND BookId = ?", userID, bookID)
+
+	readingSessions, err := paginate[models.ReadingSession](query, pagination, &models.ReadingSession{})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return readingSessions, nil
+}
+
+func (r *readingSessionRepository) DeleteReadingSessionByID(ctx context.Context, ID uuid.UUID) error {
+	if err := r.DB.WithContext(ctx).Where("Id = ?", ID).Delete(&models.ReadingSession{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *readingSessionRepository) GetReadingProgress(ctx context.Context, userID, bookID uuid.UUID) (*models.ReadingProgress, error) {
+	var progress models.ReadingProgress
+	if err := r.DB.WithContext(ctx).
+		Model(&models.ReadingSession{}).
+		Select("COALESCE(SUM(PagesRead), 0) AS PagesRead, COALESCE(SUM(Minutes), 0) AS Minutes, COUNT(*) AS TotalSessions").
+		Where("UserId = ? AND BookId = ?", userID, bookID).
+		Scan(&progress).Error; err != nil {
+		return nil, err
+	}
+
+	return &progress, nil
+}
+
+func (r *readingSessionRepository) GetReadingTotals(ctx context.Context, userID uuid.UUID) (*models.ReadingTotals, error) {
+	var totals models.ReadingTotals
+	if err := r.DB.WithContext(ctx).
+		Model(&models.ReadingSession{}).
+		Select("COALESCE(SUM(PagesRead), 0) AS PagesRead, COALESCE(SUM(Minutes), 0) AS Minutes, COUNT(*) AS Sessions").
+		Where("UserId = ?", userID).
+		Scan(&totals).Error; err != nil {
+		return nil, err
+	}
+
+	return &totals, nil
+}
+
+func (r *readingSessionRepository) GetPagesPerWeek(ctx context.Context, userID uuid.UUID, since time.Time) ([]models.PeriodCount, error) {
+	var weeks []models.PeriodCount
+	if err := r.DB.WithContext(ctx).
+		Model(&models.ReadingSession{}).
+		Select("DATE_FORMAT(DATE_SUB(Date, INTERVAL WEEKDAY(Date) DAY), '%Y-%m-%d') AS Period, SUM(PagesRead) AS Total").
+		Where("UserId = ? AND Date >= ?", userID, since).
+		Group("Period").
+		Scan(&weeks).Error; err != nil {
+		return nil, err
+	}
+
+	return weeks, nil
+}
+
+func (r *readingSessionRepository) GetBooksFinishedPerMonth(ctx context.Context, userID uuid.UUID, since time.Time) ([]models.PeriodCount, error) {
+	var months []models.PeriodCount
+	if err := r.DB.WithContext(ctx).
+		Model(&models.BookShelf{}).
+		Select("DATE_FORMAT(FinishedAt, '%Y-%m') AS Period, COUNT(*) AS Total").
+		Where("UserId = ? AND Status = ? AND FinishedAt >= ?", userID, models.ReadShelf, since).
+		Group("Period").
+		Scan(&months).Error; err != nil {
+		return nil, err
+	}
+
+	return months, nil
+}
+
+func (r *readingSessionRepository) CountFinishedBooks(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var total int64
+	if err := r.DB.WithContext(ctx).
+		Model(&models.BookShelf{}).
+		Where("UserId = ? AND Status = ?", userID, models.ReadShelf).
+		Count(&total).Error; err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+func (r *readingSessionRepository) GetFavoriteCategories(ctx context.Context, userID uuid.UUID, limit int) ([]models.FavoriteCategory, error) {
+	var categories []models.FavoriteCategory
+	if err := r.DB.WithContext(ctx).
+		Model(&models.BookShelf{}).
+		Select("Categories.Id AS CategoryId, Categories.Name AS Name, Categories.Translations AS Translations, COUNT(DISTINCT BookShelves.BookId) AS TotalBooks").
+		Joins("JOIN BookCategories ON BookCategories.BookID = BookShelves.BookId").
+		Joins("JOIN Categories ON Categories.Id = BookCategories.CategoryID AND Categories.DeletedAt IS NULL").
+		Where("BookShelves.UserId = ? AND BookShelves.Status IN ?", userID, []models.BookShelfStatus{models.ReadingShelf, models.ReadShelf}).
+		Group("Categories.Id").
+		Order("TotalBooks DESC").
+		Limit(limit).
+		Scan(&categories).Error; err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
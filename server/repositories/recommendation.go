@@ -0,0 +1,235 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type RecommendationRepository interface {
+	GetUserEvaluations(ctx context.Context, userID uuid.UUID) ([]models.Evaluation, error)
+	GetBooksWithAssociations(ctx context.Context, IDs []uuid.UUID) ([]models.Book, error)
+	GetCandidateBooks(ctx context.Context, userID uuid.UUID, categoryIDs, authorIDs []uuid.UUID, limit int) ([]models.Book, error)
+	GetCoRatedBooks(ctx context.Context, userID uuid.UUID, likedBookIDs []uuid.UUID, minRate uint8, limit int) ([]models.BookAffinity, error)
+	GetPopularBooks(ctx context.Context, userID uuid.UUID, excludedIDs []uuid.UUID, limit int) ([]models.Book, error)
+	GetPublishedBooksByID(ctx context.Context, IDs []uuid.UUID) ([]models.Book, error)
+	GetBooksSharingAssociations(ctx context.Context, bookID uuid.UUID, categoryIDs, authorIDs []uuid.UUID, limit int) ([]models.Book, error)
+	GetCoRatedBooksForBook(ctx context.Context, bookID uuid.UUID, minRate uint8, limit int) ([]models.BookAffinity, error)
+}
+
+type recommendationRepository struct {
+	di *internal.Di
+	DB *gorm.DB
+}
+
+func NewRecommendationRepository(di *internal.Di) (RecommendationRepository, error) {
+	DB, err := internal.Invoke[*gorm.DB](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recommendationRepository{
+		di: di,
+		DB: DB,
+	}, nil
+}
+
+func (r *recommendationRepository) GetUserEvaluations(ctx context.Context, userID uuid.UUID) ([]models.Evaluation, error) {
+	var evaluations []models.Evaluation
+
+	if err := r.DB.WithContext(ctx).
+		Select("Id", "BookId", "Rate").
+		Where("UserId = ? AND Status = ?", userID, models.VisibleEvaluation).
+		Find(&evaluations).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return evaluations, nil
+}
+
+func (r *recommendationRepository) GetBooksWithAssociations(ctx context.Context, IDs []uuid.UUID) ([]models.Book, error) {
+	var books []models.Book
+
+	if err := r.DB.WithContext(ctx).
+		Preload("Categories").
+		Preload("Authors").
+		Where("Id IN ?", IDs).
+		Find(&books).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return books, nil
+}
+
+func (r *recommendationRepository) GetCandidateBooks(ctx context.Context, userID uuid.UUID, categoryIDs, authorIDs []uuid.UUID, limit int) ([]models.Book, error) {
+	if len(categoryIDs) == 0 && len(authorIDs) == 0 {
+		return nil, nil
+	}
+
+	var books []models.Book
+	if err := r.DB.WithContext(ctx).
+		Preload("Categories").
+		Preload("Authors").
+		Where("Books.Published = ?", true).
+		Where("Books.Id NOT IN (?)", r.DB.Model(&models.Evaluation{}).Select("BookId").Where("UserId = ?", userID)).
+		Where(r.sharingAssociations(categoryIDs, authorIDs)).
+		Order("Books.TotalEvaluations DESC").
+		Limit(limit).
+		Find(&books).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return books, nil
+}
+
+func (r *recommendationRepository) GetCoRatedBooks(ctx context.Context, userID uuid.UUID, likedBookIDs []uuid.UUID, minRate uint8, limit int) ([]models.BookAffinity, error) {
+	if len(likedBookIDs) == 0 {
+		return nil, nil
+	}
+
+	var affinities []models.BookAffinity
+	if err := r.DB.WithContext(ctx).
+		Table("Evaluations AS Liked").
+		Select("Other.BookId AS BookId, COUNT(DISTINCT Other.UserId) AS Score").
+		Joins("JOIN Evaluations AS Other ON Other.UserId = Liked.UserId AND Other.BookId <> Liked.BookId").
+		Joins("JOIN Books ON Books.Id = Other.BookId").
+		Where("Liked.BookId IN ?", likedBookIDs).
+		Where("Liked.UserId <> ?", userID).
+		Where("Liked.Rate >= ? AND Other.Rate >= ?", minRate, minRate).
+		Where("Liked.DeletedAt IS NULL AND Other.DeletedAt IS NULL").
+		Where("Liked.Status = ? AND Other.Status = ?", models.VisibleEvaluation, models.VisibleEvaluation).
+		Where("Books.Published = ? AND Books.DeletedAt IS NULL", true).
+		Where("Other.BookId NOT IN (?)", r.DB.Model(&models.Evaluation{}).Select("BookId").Where("UserId = ?", userID)).
+		Group("Other.BookId").
+		Order("Score DESC").
+		Limit(limit).
+		Scan(&affinities).Error; err != nil {
+		return nil, err
+	}
+
+	return affinities, nil
+}
+
+func (r *recommendationRepository) GetPopularBooks(ctx context.Context, userID uuid.UUID, excludedIDs []uuid.UUID, limit int) ([]models.Book, error) {
+	query := r.DB.WithContext(ctx).
+		Preload("Categories").
+		Preload("Authors").
+		Preload("Evaluations").
+		Where("Books.Published = ?", true).
+		Where("Books.Id NOT IN (?)", r.DB.Model(&models.Evaluation{}).Select("BookId").Where("UserId = ?", userID))
+
+	if len(excludedIDs) > 0 {
+		query = query.Where("Books.Id NOT IN ?", excludedIDs)
+	}
+
+	var books []models.Book
+	if err := query.
+		Order("Books.TotalEvaluations DESC").
+		Order("Books.CreatedAt DESC").
+		Limit(limit).
+		Find(&books).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return books, nil
+}
+
+func (r *recommendationRepository) GetPublishedBooksByID(ctx context.Context, IDs []uuid.UUID) ([]models.Book, error) {
+	var books []models.Book
+
+	if err := r.DB.WithContext(ctx).
+		Preload("Categories").
+		Preload("Authors").
+		Preload("Evaluations").
+		Where("Books.Published = ?", true).
+		Where("Books.Id IN ?", IDs).
+		Find(&books).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return books, nil
+}
+
+func (r *recommendationRepository) GetBooksSharingAssociations(ctx context.Context, bookID uuid.UUID, categoryIDs, authorIDs []uuid.UUID, limit int) ([]models.Book, error) {
+	if len(categoryIDs) == 0 && len(authorIDs) == 0 {
+		return nil, nil
+	}
+
+	var books []models.Book
+	if err := r.DB.WithContext(ctx).
+		Preload("Categories").
+		Preload("Authors").
+		Where("Books.Published = ?", true).
+		Where("Books.Id <> ?", bookID).
+		Where(r.sharingAssociations(categoryIDs, authorIDs)).
+		Order("Books.TotalEvaluations DESC").
+		Limit(limit).
+		Find(&books).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return books, nil
+}
+
+func (r *recommendationRepository) GetCoRatedBooksForBook(ctx context.Context, bookID uuid.UUID, minRate uint8, limit int) ([]models.BookAffinity, error) {
+	var affinities []models.BookAffinity
+	if err := r.DB.WithContext(ctx).
+		Table("Evaluations AS Liked").
+		Select("Other.BookId AS BookId, COUNT(DISTINCT Other.UserId) AS Score").
+		Joins("JOIN Evaluations AS Other ON Other.UserId = Liked.UserId AND Other.BookId <> Liked.BookId").
+		Joins("JOIN Books ON Books.Id = Other.BookId").
+		Where("Liked.BookId = ?", bookID).
+		Where("Liked.Rate >= ? AND Other.Rate >= ?", minRate, minRate).
+		Where("Liked.DeletedAt IS NULL AND Other.DeletedAt IS NULL").
+		Where("Liked.Status = ? AND Other.Status = ?", models.VisibleEvaluation, models.VisibleEvaluation).
+		Where("Books.Published = ? AND Books.DeletedAt IS NULL", true).
+		Group("Other.BookId").
+		Order("Score DESC").
+		Limit(limit).
+		Scan(&affinities).Error; err != nil {
+		return nil, err
+	}
+
+	return affinities, nil
+}
+
+func (r *recommendationRepository) sharingAssociations(categoryIDs, authorIDs []uuid.UUID) *gorm.DB {
+	associated := r.DB.Where("1 = 0")
+	if len(categoryIDs) > 0 {
+		associated = associated.Or("Books.Id IN (?)", r.DB.Table("BookCategories").Select("BookID").Where("CategoryID IN ?", categoryIDs))
+	}
+
+	if len(authorIDs) > 0 {
+		associated = associated.Or("Books.Id IN (?)", r.DB.Table("BookAuthors").Select("BookID").Where("AuthorID IN ?", authorIDs))
+	}
+
+	return associated
+}
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 
@@ -15,10 +16,14 @@ type UserRepository interface {
 	CreateUser(ctx context.Context, user models.User) error
 	GetUserByEmail(ctx context.Context, email string, roles []models.Role) (*models.User, error)
 	GetUserByID(ctx context.Context, ID uuid.UUID, roles []models.Role) (*models.User, error)
+	GetUserByHandle(ctx context.Context, handle string) (*models.User, error)
 	GetPaginatedUsersByRole(ctx context.Context, role models.Role, pagination *models.UserPagination) (*models.PaginatedResponse[models.User], error)
 	UpdateStatus(ctx context.Context, ID uuid.UUID, status models.Status) error
 	DeleteUserByID(ctx context.Context, ID uuid.UUID) error
 	UpdateUser(ctx context.Context, user models.User) error
+	UpdateUserAvatar(ctx context.Context, userID uuid.UUID, avatar models.ImageVariants) error
+	UpdateUserHandle(ctx context.Context, userID uuid.UUID, handle string) error
+	UpdateUserPrivacy(ctx context.Context, user *models.User) error
 }
 
 type userRepository struct {
@@ -80,6 +85,20 @@ func (u *userRepository) GetUserByID(ctx context.Context, ID uuid.UUID, roles []
 	return user, nil
 }
 
+func (u *userRepository) GetUserByHandle(ctx context.Context, handle string) (*models.User, error) {
+	var user models.User
+	if err := u.DB.WithContext(ctx).
+		Where("Handle = ?", handle).
+		First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &user, nil
+}
+
 func (u *userRepository) GetPaginatedUsersByRole(ctx context.Context, role models.Role, pagination *models.UserPagination) (*models.PaginatedResponse[models.User], error) {
 	query := u.DB.WithContext(ctx).
 		Where("Users.Role = ?", role).
@@ -135,3 +154,49 @@ func (u *userRepository) UpdateUser(ctx context.Context, user models.User) error
 
 	return nil
 }
+
+func (u *userRepository) UpdateUserAvatar(ctx context.Context, userID uuid.UUID, avatar models.ImageVariants) error {
+	original := avatar[models.LargeImageVariant]
+
+	if err := u.DB.WithContext(ctx).
+		Model(&models.User{}).
+		Where("Id = ?", userID).
+		Updates(models.User{
+			Avatar:              sql.NullString{String: original.URL, Valid: original.URL != ""},
+			AvatarImageClientID: original.ID,
+			AvatarVariants:      avatar,
+		}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u *userRepository) UpdateUserHandle(ctx context.Context, userID uuid.UUID, handle string) error {
+	if err := u.DB.WithContext(ctx).
+		Model(&models.User{}).
+		Where("Id = ?", userID).
+		UpdateColumn("Handle", handle).Error; err != nil {
+		if isDuplicateKeyError(err) {
+			return models.ErrHandleAlreadyTaken
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (u *userRepository) UpdateUserPrivacy(ctx context.Context, user *models.User) error {
+	if err := u.DB.WithContext(ctx).
+		Model(&models.User{}).
+		Where("Id = ?", user.ID).
+		UpdateColumns(map[string]any{
+			"HideProfile": user.HideProfile,
+			"HideReviews": user.HideReviews,
+			"HideShelves": user.HideShelves,
+		}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/G-Villarinho/book-wise-api/cache"
 	"github.com/G-Villarinho/book-wise-api/internal"
 	"github.com/G-Villarinho/book-wise-api/models"
 	"github.com/G-Villarinho/book-wise-api/repositories"
@@ -18,21 +19,37 @@ type AuthorService interface {
 	GetAllAuthors(ctx context.Context) ([]models.AuthorBasicInfoResponse, error)
 	GetPaginatedAuthors(ctx context.Context, pagination *models.AuthorPagination) (*models.PaginatedResponse[*models.AuthorDetailsResponse], error)
 	DeleteAuthorByID(ctx context.Context, ID uuid.UUID) error
+	MergeAuthors(ctx context.Context, sourceID uuid.UUID, payload models.MergeAuthorPayload) error
+	GetAuthorProfile(ctx context.Context, ID uuid.UUID) (*models.AuthorProfileResponse, error)
+	GetPaginatedAuthorPublishedBooks(ctx context.Context, ID uuid.UUID, pagination *models.PublishedBookPagination) (*models.PaginatedResponse[*models.PublishedBookResponse], error)
 }
 
 type authorService struct {
 	di               *internal.Di
+	cacheService     cache.CacheService
 	queueService     QueueService
+	imageService     ImageService
 	authorRepository repositories.AuthorRepository
 	bookRepository   repositories.BookRepository
+	followRepository repositories.FollowRepository
 }
 
 func NewAuthorService(di *internal.Di) (AuthorService, error) {
+	cacheService, err := internal.Invoke[cache.CacheService](di)
+	if err != nil {
+		return nil, err
+	}
+
 	queueService, err := internal.Invoke[QueueService](di)
 	if err != nil {
 		return nil, err
 	}
 
+	imageService, err := internal.Invoke[ImageService](di)
+	if err != nil {
+		return nil, err
+	}
+
 	authorRepository, err := internal.Invoke[repositories.AuthorRepository](di)
 	if err != nil {
 		return nil, err
@@ -43,11 +60,19 @@ func NewAuthorService(di *internal.Di) (AuthorService, error) {
 		return nil, err
 	}
 
+	followRepository, err := internal.Invoke[repositories.FollowRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
 	return &authorService{
 		di:               di,
+		cacheService:     cacheService,
 		queueService:     queueService,
+		imageService:     imageService,
 		authorRepository: authorRepository,
 		bookRepository:   bookRepository,
+		followRepository: followRepository,
 	}, nil
 }
 
@@ -122,16 +147,135 @@ func (a *authorService) DeleteAuthorByID(ctx context.Context, ID uuid.UUID) erro
 	}
 
 	if author == nil {
-		return models.ErrAuthorsNotFound
+		return models.ErrAuthorNotFound
+	}
+
+	bookIDs, err := a.bookRepository.GetBookIDsByAuthorID(ctx, ID)
+	if err != nil {
+		return fmt.Errorf("get book ids by author id %q: %w", ID, err)
 	}
 
-	if err := a.bookRepository.DeleteBooksByAuthorID(ctx, ID); err != nil {
-		return fmt.Errorf("delete books by author id %q: %w", ID, err)
+	deletedBooks, err := a.bookRepository.DeleteSoleAuthoredBooksByAuthorID(ctx, ID)
+	if err != nil {
+		return fmt.Errorf("delete sole authored books by author id %q: %w", ID, err)
 	}
 
 	if err := a.authorRepository.DeleteAuthorByID(ctx, ID); err != nil {
 		return fmt.Errorf("delete author by id %q: %w", ID, err)
 	}
 
+	if err := a.followRepository.DeleteFollowsByTarget(ctx, models.AuthorFollowTarget, ID); err != nil {
+		return fmt.Errorf("delete follows of author %q: %w", ID, err)
+	}
+
+	imageIDs := author.AvatarImageIDs()
+	for _, book := range deletedBooks {
+		imageIDs = append(imageIDs, book.CoverImageIDs()...)
+	}
+
+	if err := a.imageService.ScheduleImageDeletion(imageIDs); err != nil {
+		return fmt.Errorf("schedule image deletion for author %q: %w", ID, err)
+	}
+
+	if err := InvalidateSimilarBooks(ctx, a.cacheService, bookIDs); err != nil {
+		return err
+	}
+
 	return nil
 }
+
+func (a *authorService) MergeAuthors(ctx context.Context, sourceID uuid.UUID, payload models.MergeAuthorPayload) error {
+	if sourceID == payload.TargetAuthorID {
+		return models.ErrMergeSameAuthor
+	}
+
+	authors, err := a.authorRepository.GetAuthorsByID(ctx, []uuid.UUID{sourceID, payload.TargetAuthorID})
+	if err != nil {
+		return fmt.Errorf("get authors by id: %w", err)
+	}
+
+	var source *models.Author
+	for i := range authors {
+		if authors[i].ID == sourceID {
+			source = &authors[i]
+		}
+	}
+
+	if len(authors) != 2 || source == nil {
+		return models.ErrAuthorNotFound
+	}
+
+	bookIDs, err := a.bookRepository.GetBookIDsByAuthorID(ctx, sourceID)
+	if err != nil {
+		return fmt.Errorf("get book ids by author id %q: %w", sourceID, err)
+	}
+
+	if err := a.authorRepository.MergeAuthors(ctx, sourceID, payload.TargetAuthorID); err != nil {
+		return fmt.Errorf("merge author %q into %q: %w", sourceID, payload.TargetAuthorID, err)
+	}
+
+	if err := a.followRepository.ReassignFollows(ctx, models.AuthorFollowTarget, sourceID, payload.TargetAuthorID); err != nil {
+		return fmt.Errorf("reassign follows from author %q to %q: %w", sourceID, payload.TargetAuthorID, err)
+	}
+
+	if err := a.imageService.ScheduleImageDeletion(source.AvatarImageIDs()); err != nil {
+		return fmt.Errorf("schedule avatar deletion for author %q: %w", sourceID, err)
+	}
+
+	if err := InvalidateSimilarBooks(ctx, a.cacheService, bookIDs); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *authorService) GetAuthorProfile(ctx context.Context, ID uuid.UUID) (*models.AuthorProfileResponse, error) {
+	author, err := a.authorRepository.GetAuthorByID(ctx, ID)
+	if err != nil {
+		return nil, fmt.Errorf("get author by id %q: %w", ID, err)
+	}
+
+	if author == nil {
+		return nil, models.ErrAuthorNotFound
+	}
+
+	totalPublishedBooks, err := a.bookRepository.CountPublishedBooksByAuthorID(ctx, ID)
+	if err != nil {
+		return nil, fmt.Errorf("count published books by author id %q: %w", ID, err)
+	}
+
+	return author.ToAuthorProfileResponse(totalPublishedBooks), nil
+}
+
+func (a *authorService) GetPaginatedAuthorPublishedBooks(ctx context.Context, ID uuid.UUID, pagination *models.PublishedBookPagination) (*models.PaginatedResponse[*models.PublishedBookResponse], error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	author, err := a.authorRepository.GetAuthorByID(ctx, ID)
+	if err != nil {
+		return nil, fmt.Errorf("get author by id %q: %w", ID, err)
+	}
+
+	if author == nil {
+		return nil, models.ErrAuthorNotFound
+	}
+
+	authorID := ID.String()
+	pagination.AuthorID = &authorID
+
+	paginatedPublishedBooks, err := a.bookRepository.GetPaginatedPublishedBooks(ctx, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("get paginated published books by author id %q: %w", ID, err)
+	}
+
+	paginatedPublishedBooksResponse := models.MapPaginatedResult(paginatedPublishedBooks, func(publishedBook models.Book) *models.PublishedBookResponse {
+		rateAverage := calculateAverageRating(publishedBook.Evaluations)
+
+		hasRead := userHasReadBook(session.UserID, publishedBook.Evaluations)
+		return publishedBook.ToPublishedBookResponse(rateAverage, hasRead)
+	})
+
+	return paginatedPublishedBooksResponse, nil
+}
@@ -2,13 +2,19 @@ package services
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"log/slog"
+	"time"
 
+	"github.com/G-Villarinho/book-wise-api/cache"
 	"github.com/G-Villarinho/book-wise-api/clients"
 	"github.com/G-Villarinho/book-wise-api/internal"
 	"github.com/G-Villarinho/book-wise-api/models"
 	"github.com/G-Villarinho/book-wise-api/repositories"
+	"github.com/G-Villarinho/book-wise-api/utils"
 	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
 )
 
 type BookService interface {
@@ -22,16 +28,29 @@ type BookService interface {
 	UnpublishBook(ctx context.Context, ID uuid.UUID) error
 	EvaluateBook(ctx context.Context, bookID uuid.UUID, payload models.CreateEvaluationPayload) (*models.EvaluationBasicInfoResponse, error)
 	GetPaginatedPublishedBooks(ctx context.Context, pagination *models.PublishedBookPagination) (*models.PaginatedResponse[*models.PublishedBookResponse], error)
+	GetPublishedBookDetails(ctx context.Context, ID uuid.UUID) (*models.PublishedBookDetailsResponse, error)
 	GetPaginatedBookEvaluationsByID(ctx context.Context, bookID uuid.UUID, pagination *models.Pagination) (*models.PaginatedResponse[*models.EvaluationBasicInfoResponse], error)
+	UpdateBookCover(ctx context.Context, bookID uuid.UUID, payload models.UpdateBookCoverPayload) error
+	UpdateBookShelf(ctx context.Context, bookID uuid.UUID, payload models.UpdateBookShelfPayload) (*models.BookShelfResponse, error)
+	RemoveBookShelf(ctx context.Context, bookID uuid.UUID) error
 }
 
 type bookService struct {
-	di                *internal.Di
-	googleBookClient  clients.GoogleBookClient
-	categoryService   CategoryService
-	evaluationService EvaluationService
-	authorRepository  repositories.AuthorRepository
-	bookRepository    repositories.BookRepository
+	di                    *internal.Di
+	googleBookClient      clients.GoogleBookClient
+	cacheService          cache.CacheService
+	categoryService       CategoryService
+	evaluationService     EvaluationService
+	queueService          QueueService
+	imageService          ImageService
+	recommendationService RecommendationService
+	notificationService   NotificationService
+	eventService          EventService
+	feedService           FeedService
+	authorRepository      repositories.AuthorRepository
+	bookRepository        repositories.BookRepository
+	bookShelfRepository   repositories.BookShelfRepository
+	evaluationRepository  repositories.EvaluationRepository
 }
 
 func NewBookService(di *internal.Di) (BookService, error) {
@@ -40,6 +59,11 @@ func NewBookService(di *internal.Di) (BookService, error) {
 		return nil, err
 	}
 
+	cacheService, err := internal.Invoke[cache.CacheService](di)
+	if err != nil {
+		return nil, err
+	}
+
 	evaluationService, err := internal.Invoke[EvaluationService](di)
 	if err != nil {
 		return nil, err
@@ -50,6 +74,36 @@ func NewBookService(di *internal.Di) (BookService, error) {
 		return nil, err
 	}
 
+	queueService, err := internal.Invoke[QueueService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	imageService, err := internal.Invoke[ImageService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	recommendationService, err := internal.Invoke[RecommendationService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	notificationService, err := internal.Invoke[NotificationService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	eventService, err := internal.Invoke[EventService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	feedService, err := internal.Invoke[FeedService](di)
+	if err != nil {
+		return nil, err
+	}
+
 	authorRepository, err := internal.Invoke[repositories.AuthorRepository](di)
 	if err != nil {
 		return nil, err
@@ -60,13 +114,32 @@ func NewBookService(di *internal.Di) (BookService, error) {
 		return nil, err
 	}
 
+	bookShelfRepository, err := internal.Invoke[repositories.BookShelfRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	evaluationRepository, err := internal.Invoke[repositories.EvaluationRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
 	return &bookService{
-		di:                di,
-		googleBookClient:  googleBookClient,
-		evaluationService: evaluationService,
-		categoryService:   categoryService,
-		authorRepository:  authorRepository,
-		bookRepository:    bookRepository,
+		di:                    di,
+		googleBookClient:      googleBookClient,
+		cacheService:          cacheService,
+		evaluationService:     evaluationService,
+		categoryService:       categoryService,
+		queueService:          queueService,
+		imageService:          imageService,
+		recommendationService: recommendationService,
+		notificationService:   notificationService,
+		eventService:          eventService,
+		feedService:           feedService,
+		authorRepository:      authorRepository,
+		bookRepository:        bookRepository,
+		bookShelfRepository:   bookShelfRepository,
+		evaluationRepository:  evaluationRepository,
 	}, nil
 }
 
@@ -95,6 +168,12 @@ func (b *bookService) CreateBook(ctx context.Context, payload models.CreateBookP
 		return nil, fmt.Errorf("create book: %w", err)
 	}
 
+	if payload.CoverImageURL != "" {
+		if err := b.publishBookCoverTask(models.BookCoverTask{BookID: book.ID, SourceURL: payload.CoverImageURL}); err != nil {
+			slog.Error(err.Error())
+		}
+	}
+
 	return book.ToBookResponse(), nil
 }
 
@@ -169,6 +248,14 @@ func (b *bookService) DeleteBookByID(ctx context.Context, ID uuid.UUID) error {
 		return fmt.Errorf("delete book by id %q: %w", ID, err)
 	}
 
+	if err := b.imageService.ScheduleImageDeletion(book.CoverImageIDs()); err != nil {
+		return fmt.Errorf("schedule cover deletion for book %q: %w", ID, err)
+	}
+
+	if err := InvalidateSimilarBooks(ctx, b.cacheService, []uuid.UUID{ID}); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -190,7 +277,20 @@ func (b *bookService) PublishBook(ctx context.Context, ID uuid.UUID) error {
 		return fmt.Errorf("update publication status book %q: %w", ID, err)
 	}
 
-	return nil
+	if err := b.notificationService.ScheduleBookFollowersNotification(ID); err != nil {
+		slog.Error(err.Error())
+	}
+
+	event := map[string]any{
+		"bookId": ID,
+		"title":  book.Title,
+	}
+
+	if err := b.eventService.PublishToAll(ctx, models.BookPublishedEvent, event); err != nil {
+		slog.Error(err.Error())
+	}
+
+	return InvalidateSimilarBooks(ctx, b.cacheService, []uuid.UUID{ID})
 }
 
 func (b *bookService) UnpublishBook(ctx context.Context, ID uuid.UUID) error {
@@ -211,7 +311,7 @@ func (b *bookService) UnpublishBook(ctx context.Context, ID uuid.UUID) error {
 		return fmt.Errorf("update publication status book %q: %w", ID, err)
 	}
 
-	return nil
+	return InvalidateSimilarBooks(ctx, b.cacheService, []uuid.UUID{ID})
 }
 
 func (b *bookService) EvaluateBook(ctx context.Context, bookID uuid.UUID, payload models.CreateEvaluationPayload) (*models.EvaluationBasicInfoResponse, error) {
@@ -229,6 +329,18 @@ func (b *bookService) EvaluateBook(ctx context.Context, bookID uuid.UUID, payloa
 		return nil, err
 	}
 
+	if session, ok := ctx.Value(internal.SessionKey).(models.Session); ok {
+		if err := b.recommendationService.ScheduleRecommendations(session.UserID); err != nil {
+			slog.Error(err.Error())
+		}
+	}
+
+	if evaluationBasicInfoResponse.Status == models.VisibleEvaluation {
+		if err := b.publishEvaluationCreated(ctx, bookID); err != nil {
+			slog.Error(err.Error())
+		}
+	}
+
 	return evaluationBasicInfoResponse, nil
 }
 
@@ -253,6 +365,39 @@ func (b *bookService) GetPaginatedPublishedBooks(ctx context.Context, pagination
 	return paginatedPublishedBooksResponse, nil
 }
 
+func (b *bookService) GetPublishedBookDetails(ctx context.Context, ID uuid.UUID) (*models.PublishedBookDetailsResponse, error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	book, err := b.bookRepository.GetBookByID(ctx, ID, true)
+	if err != nil {
+		return nil, fmt.Errorf("get book by id %q: %w", ID, err)
+	}
+
+	if book == nil || !book.Published {
+		return nil, models.ErrBookNotFound
+	}
+
+	ratings, err := b.evaluationRepository.GetRatingBreakdown(ctx, ID)
+	if err != nil {
+		return nil, fmt.Errorf("get rating breakdown for book %q: %w", ID, err)
+	}
+
+	userEvaluation, err := b.evaluationRepository.GetUserEvaluationForBook(ctx, session.UserID, ID)
+	if err != nil {
+		return nil, fmt.Errorf("get user %q evaluation to book %q: %w", session.UserID, ID, err)
+	}
+
+	shelf, err := b.bookShelfRepository.GetBookShelf(ctx, session.UserID, ID)
+	if err != nil {
+		return nil, fmt.Errorf("get user %q shelf for book %q: %w", session.UserID, ID, err)
+	}
+
+	return book.ToPublishedBookDetailsResponse(models.GetLocaleFromContext(ctx), ratings, userEvaluation, shelf), nil
+}
+
 func (b *bookService) GetPaginatedBookEvaluationsByID(ctx context.Context, bookID uuid.UUID, pagination *models.Pagination) (*models.PaginatedResponse[*models.EvaluationBasicInfoResponse], error) {
 	book, err := b.bookRepository.GetBookByID(ctx, bookID, true)
 	if err != nil {
@@ -271,10 +416,134 @@ func (b *bookService) GetPaginatedBookEvaluationsByID(ctx context.Context, bookI
 	return paginatedBookEvaluationsResponse, nil
 }
 
+func (b *bookService) UpdateBookCover(ctx context.Context, bookID uuid.UUID, payload models.UpdateBookCoverPayload) error {
+	book, err := b.bookRepository.GetBookByID(ctx, bookID, false)
+	if err != nil {
+		return fmt.Errorf("get book by id %q: %w", bookID, err)
+	}
+
+	if book == nil {
+		return models.ErrBookNotFound
+	}
+
+	image, err := utils.ConvertImageToBytes(payload.Image)
+	if err != nil {
+		return err
+	}
+
+	return b.publishBookCoverTask(models.BookCoverTask{BookID: book.ID, Image: image})
+}
+
+func (b *bookService) UpdateBookShelf(ctx context.Context, bookID uuid.UUID, payload models.UpdateBookShelfPayload) (*models.BookShelfResponse, error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	book, err := b.bookRepository.GetBookByID(ctx, bookID, false)
+	if err != nil {
+		return nil, fmt.Errorf("get book by id %q: %w", bookID, err)
+	}
+
+	if book == nil || !book.Published {
+		return nil, models.ErrBookNotFound
+	}
+
+	bookShelf, err := b.bookShelfRepository.GetBookShelf(ctx, session.UserID, bookID)
+	if err != nil {
+		return nil, fmt.Errorf("get user %q shelf for book %q: %w", session.UserID, bookID, err)
+	}
+
+	now := time.Now().UTC()
+	if bookShelf == nil {
+		bookShelf = models.NewBookShelf(session.UserID, bookID, now)
+	} else {
+		bookShelf.UpdatedAt = sql.NullTime{Time: now, Valid: true}
+	}
+
+	finished := payload.Status == models.ReadShelf && bookShelf.Status != models.ReadShelf
+	bookShelf.ApplyStatus(payload.Status, now)
+	if err := b.bookShelfRepository.SaveBookShelf(ctx, bookShelf); err != nil {
+		return nil, fmt.Errorf("save user %q shelf for book %q: %w", session.UserID, bookID, err)
+	}
+
+	if finished {
+		if err := b.feedService.RecordActivity(ctx, models.NewBookFinishedActivity(session.UserID, bookID)); err != nil {
+			slog.Error(err.Error())
+		}
+	}
+
+	return bookShelf.ToBookShelfResponse(), nil
+}
+
+func (b *bookService) RemoveBookShelf(ctx context.Context, bookID uuid.UUID) error {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return models.ErrUserNotFoundInContext
+	}
+
+	bookShelf, err := b.bookShelfRepository.GetBookShelf(ctx, session.UserID, bookID)
+	if err != nil {
+		return fmt.Errorf("get user %q shelf for book %q: %w", session.UserID, bookID, err)
+	}
+
+	if bookShelf == nil {
+		return models.ErrBookShelfNotFound
+	}
+
+	if err := b.bookShelfRepository.DeleteBookShelf(ctx, session.UserID, bookID); err != nil {
+		return fmt.Errorf("delete user %q shelf for book %q: %w", session.UserID, bookID, err)
+	}
+
+	return nil
+}
+
+// publishEvaluationCreated broadcasts the evaluation as other readers see it,
+// so the author's privacy settings apply to the event as well.
+func (b *bookService) publishEvaluationCreated(ctx context.Context, bookID uuid.UUID) error {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return models.ErrUserNotFoundInContext
+	}
+
+	evaluation, err := b.evaluationRepository.GetUserEvaluationForBook(ctx, session.UserID, bookID)
+	if err != nil {
+		return fmt.Errorf("get user %q evaluation to book %q: %w", session.UserID, bookID, err)
+	}
+
+	if evaluation == nil {
+		return models.ErrEvaluationNotFound
+	}
+
+	event := map[string]any{
+		"bookId":     bookID,
+		"evaluation": evaluation.ToEvaluationBasicInfoResponse(uuid.Nil),
+	}
+
+	return b.eventService.PublishToAll(ctx, models.EvaluationCreatedEvent, event)
+}
+
+func (b *bookService) publishBookCoverTask(task models.BookCoverTask) error {
+	message, err := jsoniter.Marshal(task)
+	if err != nil {
+		return fmt.Errorf("marshal book cover task: %w", err)
+	}
+
+	if err := b.queueService.Publish(UploadBookCover, message); err != nil {
+		return fmt.Errorf("publish book cover task: %w", err)
+	}
+
+	return nil
+}
+
 func calculateAverageRating(evaluations []models.Evaluation) float32 {
 	var sum float32
 	var count int
 	for _, eval := range evaluations {
+		if eval.Status != models.VisibleEvaluation {
+			continue
+		}
+
 		sum += float32(eval.Rate)
 		count++
 	}
@@ -0,0 +1,351 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/repositories"
+	"github.com/google/uuid"
+)
+
+type BookListService interface {
+	CreateBookList(ctx context.Context, payload models.CreateBookListPayload) (*models.BookListResponse, error)
+	GetBookList(ctx context.Context, ID uuid.UUID) (*models.BookListDetailsResponse, error)
+	UpdateBookList(ctx context.Context, ID uuid.UUID, payload models.UpdateBookListPayload) (*models.BookListResponse, error)
+	DeleteBookList(ctx context.Context, ID uuid.UUID) error
+	GetPaginatedPublicBookLists(ctx context.Context, pagination *models.BookListPagination) (*models.PaginatedResponse[*models.BookListResponse], error)
+	GetPaginatedUserBookLists(ctx context.Context, pagination *models.BookListPagination) (*models.PaginatedResponse[*models.BookListResponse], error)
+	AddBookToList(ctx context.Context, ID uuid.UUID, payload models.AddBookListItemPayload) error
+	UpdateBookListItem(ctx context.Context, ID, bookID uuid.UUID, payload models.UpdateBookListItemPayload) error
+	RemoveBookFromList(ctx context.Context, ID, bookID uuid.UUID) error
+	ReorderBookList(ctx context.Context, ID uuid.UUID, payload models.ReorderBookListPayload) error
+}
+
+type bookListService struct {
+	di                 *internal.Di
+	feedService        FeedService
+	bookRepository     repositories.BookRepository
+	bookListRepository repositories.BookListRepository
+}
+
+func NewBookListService(di *internal.Di) (BookListService, error) {
+	feedService, err := internal.Invoke[FeedService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	bookRepository, err := internal.Invoke[repositories.BookRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	bookListRepository, err := internal.Invoke[repositories.BookListRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bookListService{
+		di:                 di,
+		feedService:        feedService,
+		bookRepository:     bookRepository,
+		bookListRepository: bookListRepository,
+	}, nil
+}
+
+func (b *bookListService) CreateBookList(ctx context.Context, payload models.CreateBookListPayload) (*models.BookListResponse, error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	bookList := payload.ToBookList(session.UserID)
+	if err := b.bookListRepository.CreateBookList(ctx, bookList); err != nil {
+		return nil, fmt.Errorf("create book list: %w", err)
+	}
+
+	if bookList.Visibility == models.PublicBookList {
+		b.recordBookListActivity(ctx, bookList)
+	}
+
+	createdBookList, err := b.bookListRepository.GetBookListByID(ctx, bookList.ID, false)
+	if err != nil {
+		return nil, fmt.Errorf("get book list by id %q: %w", bookList.ID, err)
+	}
+
+	if createdBookList == nil {
+		return nil, models.ErrBookListNotFound
+	}
+
+	return createdBookList.ToBookListResponse(session.UserID), nil
+}
+
+func (b *bookListService) GetBookList(ctx context.Context, ID uuid.UUID) (*models.BookListDetailsResponse, error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	bookList, err := b.bookListRepository.GetBookListByID(ctx, ID, true)
+	if err != nil {
+		return nil, fmt.Errorf("get book list by id %q: %w", ID, err)
+	}
+
+	if bookList == nil || !bookList.CanBeViewedBy(session.UserID) {
+		return nil, models.ErrBookListNotFound
+	}
+
+	items := make([]models.BookListItemResponse, 0, len(bookList.Items))
+	for _, item := range bookList.Items {
+		if !item.Book.Published {
+			continue
+		}
+
+		rateAverage := calculateAverageRating(item.Book.Evaluations)
+		hasRead := userHasReadBook(session.UserID, item.Book.Evaluations)
+		items = append(items, models.BookListItemResponse{
+			Position: item.Position,
+			Note:     item.Note,
+			Book:     item.Book.ToPublishedBookResponse(rateAverage, hasRead),
+		})
+	}
+
+	return &models.BookListDetailsResponse{
+		BookListResponse: *bookList.ToBookListResponse(session.UserID),
+		Items:            items,
+	}, nil
+}
+
+func (b *bookListService) UpdateBookList(ctx context.Context, ID uuid.UUID, payload models.UpdateBookListPayload) (*models.BookListResponse, error) {
+	bookList, err := b.getOwnedBookList(ctx, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	published := bookList.Visibility != models.PublicBookList && payload.Visibility == models.PublicBookList
+	bookList.Title = payload.Title
+	bookList.Description = payload.Description
+	bookList.Visibility = payload.Visibility
+	bookList.UpdatedAt = sql.NullTime{Time: time.Now().UTC(), Valid: true}
+	if err := b.bookListRepository.UpdateBookList(ctx, bookList); err != nil {
+		return nil, fmt.Errorf("update book list %q: %w", ID, err)
+	}
+
+	if published {
+		b.recordBookListActivity(ctx, bookList)
+	}
+
+	return bookList.ToBookListResponse(bookList.UserID), nil
+}
+
+func (b *bookListService) DeleteBookList(ctx context.Context, ID uuid.UUID) error {
+	if _, err := b.getOwnedBookList(ctx, ID); err != nil {
+		return err
+	}
+
+	if err := b.bookListRepository.DeleteBookListByID(ctx, ID); err != nil {
+		return fmt.Errorf("delete book list %q: %w", ID, err)
+	}
+
+	return nil
+}
+
+func (b *bookListService) GetPaginatedPublicBookLists(ctx context.Context, pagination *models.BookListPagination) (*models.PaginatedResponse[*models.BookListResponse], error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	paginatedBookLists, err := b.bookListRepository.GetPaginatedPublicBookLists(ctx, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("get paginated public book lists: %w", err)
+	}
+
+	paginatedBookListsResponse := models.MapPaginatedResult(paginatedBookLists, func(bookList models.BookList) *models.BookListResponse {
+		return bookList.ToBookListResponse(session.UserID)
+	})
+
+	return paginatedBookListsResponse, nil
+}
+
+func (b *bookListService) GetPaginatedUserBookLists(ctx context.Context, pagination *models.BookListPagination) (*models.PaginatedResponse[*models.BookListResponse], error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	paginatedBookLists, err := b.bookListRepository.GetPaginatedUserBookLists(ctx, session.UserID, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("get paginated book lists of user %q: %w", session.UserID, err)
+	}
+
+	containing := make(map[uuid.UUID]bool)
+	if pagination.BookID != nil && paginatedBookLists != nil {
+		bookListIDs := make([]uuid.UUID, 0, len(paginatedBookLists.Data))
+		for _, bookList := range paginatedBookLists.Data {
+			bookListIDs = append(bookListIDs, bookList.ID)
+		}
+
+		IDs, err := b.bookListRepository.GetBookListIDsContainingBook(ctx, bookListIDs, *pagination.BookID)
+		if err != nil {
+			return nil, fmt.Errorf("get book lists containing book %q: %w", *pagination.BookID, err)
+		}
+
+		for _, ID := range IDs {
+			containing[ID] = true
+		}
+	}
+
+	paginatedBookListsResponse := models.MapPaginatedResult(paginatedBookLists, func(bookList models.BookList) *models.BookListResponse {
+		response := bookList.ToBookListResponse(session.UserID)
+		if pagination.BookID != nil {
+			containsBook := containing[bookList.ID]
+			response.ContainsBook = &containsBook
+		}
+		return response
+	})
+
+	return paginatedBookListsResponse, nil
+}
+
+func (b *bookListService) AddBookToList(ctx context.Context, ID uuid.UUID, payload models.AddBookListItemPayload) error {
+	if _, err := b.getOwnedBookList(ctx, ID); err != nil {
+		return err
+	}
+
+	book, err := b.bookRepository.GetBookByID(ctx, payload.BookID, false)
+	if err != nil {
+		return fmt.Errorf("get book by id %q: %w", payload.BookID, err)
+	}
+
+	if book == nil || !book.Published {
+		return models.ErrBookNotFound
+	}
+
+	added, err := b.bookListRepository.AddBookListItem(ctx, payload.ToBookListItem(ID))
+	if err != nil {
+		return fmt.Errorf("add book %q to list %q: %w", payload.BookID, ID, err)
+	}
+
+	if !added {
+		return models.ErrBookAlreadyInList
+	}
+
+	return nil
+}
+
+func (b *bookListService) UpdateBookListItem(ctx context.Context, ID, bookID uuid.UUID, payload models.UpdateBookListItemPayload) error {
+	item, err := b.getOwnedBookListItem(ctx, ID, bookID)
+	if err != nil {
+		return err
+	}
+
+	item.Note = payload.Note
+	item.UpdatedAt = sql.NullTime{Time: time.Now().UTC(), Valid: true}
+	if err := b.bookListRepository.UpdateBookListItem(ctx, item); err != nil {
+		return fmt.Errorf("update book %q on list %q: %w", bookID, ID, err)
+	}
+
+	return nil
+}
+
+func (b *bookListService) RemoveBookFromList(ctx context.Context, ID, bookID uuid.UUID) error {
+	item, err := b.getOwnedBookListItem(ctx, ID, bookID)
+	if err != nil {
+		return err
+	}
+
+	if err := b.bookListRepository.RemoveBookListItem(ctx, item); err != nil {
+		return fmt.Errorf("remove book %q from list %q: %w", bookID, ID, err)
+	}
+
+	return nil
+}
+
+func (b *bookListService) ReorderBookList(ctx context.Context, ID uuid.UUID, payload models.ReorderBookListPayload) error {
+	if _, err := b.getOwnedBookList(ctx, ID); err != nil {
+		return err
+	}
+
+	bookList, err := b.bookListRepository.GetBookListByID(ctx, ID, true)
+	if err != nil {
+		return fmt.Errorf("get book list by id %q: %w", ID, err)
+	}
+
+	if bookList == nil {
+		return models.ErrBookListNotFound
+	}
+
+	if len(payload.BookIDs) != len(bookList.Items) {
+		return models.ErrInvalidBookListOrder
+	}
+
+	current := make(map[uuid.UUID]bool, len(bookList.Items))
+	for _, item := range bookList.Items {
+		current[item.BookID] = true
+	}
+
+	for _, bookID := range payload.BookIDs {
+		if !current[bookID] {
+			return models.ErrInvalidBookListOrder
+		}
+		delete(current, bookID)
+	}
+
+	if err := b.bookListRepository.ReorderBookList(ctx, ID, payload.BookIDs); err != nil {
+		return fmt.Errorf("reorder book list %q: %w", ID, err)
+	}
+
+	return nil
+}
+
+func (b *bookListService) getOwnedBookList(ctx context.Context, ID uuid.UUID) (*models.BookList, error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	bookList, err := b.bookListRepository.GetBookListByID(ctx, ID, false)
+	if err != nil {
+		return nil, fmt.Errorf("get book list by id %q: %w", ID, err)
+	}
+
+	if bookList == nil || !bookList.CanBeViewedBy(session.UserID) {
+		return nil, models.ErrBookListNotFound
+	}
+
+	if bookList.UserID != session.UserID {
+		return nil, models.ErrBookListNotOwned
+	}
+
+	return bookList, nil
+}
+
+func (b *bookListService) getOwnedBookListItem(ctx context.Context, ID, bookID uuid.UUID) (*models.BookListItem, error) {
+	if _, err := b.getOwnedBookList(ctx, ID); err != nil {
+		return nil, err
+	}
+
+	item, err := b.bookListRepository.GetBookListItem(ctx, ID, bookID)
+	if err != nil {
+		return nil, fmt.Errorf("get book %q on list %q: %w", bookID, ID, err)
+	}
+
+	if item == nil {
+		return nil, models.ErrBookNotInList
+	}
+
+	return item, nil
+}
+
+// recordBookListActivity announces the list to the owner's followers once it
+// becomes public, either on creation or when its visibility is changed.
+func (b *bookListService) recordBookListActivity(ctx context.Context, bookList *models.BookList) {
+	if err := b.feedService.RecordActivity(ctx, models.NewBookListCreatedActivity(bookList)); err != nil {
+		slog.Error(err.Error())
+	}
+}
@@ -2,8 +2,13 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/G-Villarinho/book-wise-api/cache"
+	"github.com/G-Villarinho/book-wise-api/config"
 	"github.com/G-Villarinho/book-wise-api/internal"
 	"github.com/G-Villarinho/book-wise-api/models"
 	"github.com/G-Villarinho/book-wise-api/repositories"
@@ -14,31 +19,74 @@ import (
 type CategoryService interface {
 	FindOrCreateCategories(ctx context.Context, names []string) ([]models.Category, error)
 	GetAllCategories(ctx context.Context) ([]models.CategoryResponse, error)
-	GetTopCategories(ctx context.Context) ([]models.CategoryResponse, error)
+	GetTopCategories(ctx context.Context, limit int) ([]models.TopCategoryResponse, error)
+	RefreshTopCategories(ctx context.Context) ([]models.TopCategory, error)
+	GetCategoryBySlug(ctx context.Context, slug string) (*models.CategoryResponse, error)
+	CreateCategory(ctx context.Context, payload models.CreateCategoryPayload) (*models.CategoryResponse, error)
+	UpdateCategory(ctx context.Context, ID uuid.UUID, payload models.UpdateCategoryPayload) (*models.CategoryResponse, error)
+	MergeCategories(ctx context.Context, sourceID uuid.UUID, payload models.MergeCategoryPayload) error
+	DeleteCategoryByID(ctx context.Context, ID uuid.UUID) error
 }
 
+const (
+	topCategoriesKey                    = "categories:top"
+	defaultTopCategoriesWindowDays      = 30
+	defaultTopCategoriesRefreshInterval = 15 * time.Minute
+	maxCategorySlugAttempts             = 3
+)
+
 type categoryService struct {
 	di                 *internal.Di
+	cacheService       cache.CacheService
+	bookRepository     repositories.BookRepository
 	categoryRepository repositories.CategoryRepository
+	followRepository   repositories.FollowRepository
 }
 
 func NewCategoryService(di *internal.Di) (CategoryService, error) {
+	cacheService, err := internal.Invoke[cache.CacheService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	bookRepository, err := internal.Invoke[repositories.BookRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
 	categoryRepository, err := internal.Invoke[repositories.CategoryRepository](di)
 	if err != nil {
 		return nil, err
 	}
 
+	followRepository, err := internal.Invoke[repositories.FollowRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
 	return &categoryService{
 		di:                 di,
+		cacheService:       cacheService,
+		bookRepository:     bookRepository,
 		categoryRepository: categoryRepository,
+		followRepository:   followRepository,
 	}, nil
 }
 
 func (c *categoryService) FindOrCreateCategories(ctx context.Context, names []string) ([]models.Category, error) {
+	var paths [][]string
 	var normalizedNames []string
 
 	for _, name := range names {
-		normalizedNames = append(normalizedNames, utils.NormalizeString(name))
+		segments := models.SplitCategoryPath(name)
+		if len(segments) == 0 {
+			continue
+		}
+
+		paths = append(paths, segments)
+		for depth := 1; depth <= len(segments); depth++ {
+			normalizedNames = append(normalizedNames, models.NormalizeCategoryPath(segments[:depth]))
+		}
 	}
 
 	existingCategories, err := c.categoryRepository.GetCategoriesByNormalizeNames(ctx, normalizedNames)
@@ -46,37 +94,81 @@ func (c *categoryService) FindOrCreateCategories(ctx context.Context, names []st
 		return nil, fmt.Errorf("get categories by normalized names: %v", err)
 	}
 
-	existingMap := make(map[string]struct{})
+	resolved := make(map[string]models.Category)
 	for _, category := range existingCategories {
-		existingMap[category.NormalizedName] = struct{}{}
+		resolved[category.NormalizedName] = category
+	}
+
+	aliases, err := c.categoryRepository.GetCategoryAliases(ctx, normalizedNames)
+	if err != nil {
+		return nil, fmt.Errorf("get category aliases: %v", err)
+	}
+
+	for _, alias := range aliases {
+		resolved[alias.NormalizedName] = alias.Category
 	}
 
+	leafIDs := make(map[uuid.UUID]struct{})
 	var newCategories []models.Category
-	for _, name := range names {
-		ID, err := uuid.NewV7()
-		if err != nil {
-			return nil, fmt.Errorf("genereate id key: %v", err)
+	var slugNames []string
+	var categories []models.Category
+	for _, segments := range paths {
+		var parentID *uuid.UUID
+		var leaf models.Category
+
+		for depth := 1; depth <= len(segments); depth++ {
+			normalizedName := models.NormalizeCategoryPath(segments[:depth])
+
+			category, exists := resolved[normalizedName]
+			if !exists {
+				ID, err := uuid.NewV7()
+				if err != nil {
+					return nil, fmt.Errorf("genereate id key: %v", err)
+				}
+
+				category = models.Category{
+					BaseModel: models.BaseModel{
+						ID: ID,
+					},
+					ParentID:       parentID,
+					Name:           segments[depth-1],
+					NormalizedName: normalizedName,
+				}
+
+				resolved[normalizedName] = category
+				newCategories = append(newCategories, category)
+				slugNames = append(slugNames, strings.Join(segments[:depth], " "))
+			}
+
+			categoryID := category.ID
+			parentID = &categoryID
+			leaf = category
 		}
 
-		normalizedName := utils.NormalizeString(name)
-		if _, exists := existingMap[normalizedName]; !exists {
-			newCategories = append(newCategories, models.Category{
-				BaseModel: models.BaseModel{
-					ID: ID,
-				},
-				Name:           name,
-				NormalizedName: normalizedName,
-			})
+		if _, exists := leafIDs[leaf.ID]; !exists {
+			leafIDs[leaf.ID] = struct{}{}
+			categories = append(categories, leaf)
 		}
 	}
 
 	if len(newCategories) > 0 {
-		if err = c.categoryRepository.CreateBatch(ctx, newCategories); err != nil {
-			return nil, fmt.Errorf("create batch: %v", err)
+		if err := c.createCategories(ctx, newCategories, slugNames); err != nil {
+			return nil, err
+		}
+
+		slugs := make(map[uuid.UUID]string, len(newCategories))
+		for _, category := range newCategories {
+			slugs[category.ID] = category.Slug
+		}
+
+		for i := range categories {
+			if slug, exists := slugs[categories[i].ID]; exists {
+				categories[i].Slug = slug
+			}
 		}
 	}
 
-	return append(existingCategories, newCategories...), nil
+	return categories, nil
 }
 
 func (c *categoryService) GetAllCategories(ctx context.Context) ([]models.CategoryResponse, error) {
@@ -89,28 +181,404 @@ func (c *categoryService) GetAllCategories(ctx context.Context) ([]models.Catego
 		return nil, models.ErrCategoriesNotFound
 	}
 
+	locale := models.GetLocaleFromContext(ctx)
+
 	var categoriesResponse []models.CategoryResponse
 	for _, category := range categories {
-		categoriesResponse = append(categoriesResponse, *category.ToCategoryResponse())
+		categoriesResponse = append(categoriesResponse, *category.ToCategoryResponse(locale))
 	}
 
 	return categoriesResponse, nil
 }
 
-func (c *categoryService) GetTopCategories(ctx context.Context) ([]models.CategoryResponse, error) {
-	categories, err := c.categoryRepository.GetTopCategories(ctx)
+func (c *categoryService) GetTopCategories(ctx context.Context, limit int) ([]models.TopCategoryResponse, error) {
+	var topCategories []models.TopCategory
+	err := c.cacheService.Get(ctx, topCategoriesKey, &topCategories)
 	if err != nil {
-		return nil, fmt.Errorf("get all categories: %w", err)
+		if !errors.Is(err, cache.ErrCacheMiss) {
+			return nil, fmt.Errorf("get top categories from cache: %w", err)
+		}
+
+		topCategories, err = c.RefreshTopCategories(ctx)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	if categories == nil {
+	if len(topCategories) == 0 {
 		return nil, models.ErrCategoriesNotFound
 	}
 
-	var categoriesResponse []models.CategoryResponse
-	for _, category := range categories {
-		categoriesResponse = append(categoriesResponse, *category.ToCategoryResponse())
+	if len(topCategories) > limit {
+		topCategories = topCategories[:limit]
 	}
 
-	return categoriesResponse, nil
+	locale := models.GetLocaleFromContext(ctx)
+
+	var topCategoriesResponse []models.TopCategoryResponse
+	for _, topCategory := range topCategories {
+		topCategoriesResponse = append(topCategoriesResponse, *topCategory.ToTopCategoryResponse(locale))
+	}
+
+	return topCategoriesResponse, nil
+}
+
+func (c *categoryService) RefreshTopCategories(ctx context.Context) ([]models.TopCategory, error) {
+	windowDays := config.Env.TopCategories.WindowDays
+	if windowDays <= 0 {
+		windowDays = defaultTopCategoriesWindowDays
+	}
+
+	since := time.Now().UTC().AddDate(0, 0, -windowDays)
+	activities, err := c.categoryRepository.GetCategoriesActivity(ctx, since, models.MaxTopCategoriesLimit)
+	if err != nil {
+		return nil, fmt.Errorf("get categories activity: %w", err)
+	}
+
+	topCategories := make([]models.TopCategory, 0, len(activities))
+	if len(activities) > 0 {
+		IDs := make([]uuid.UUID, 0, len(activities))
+		for _, activity := range activities {
+			IDs = append(IDs, activity.CategoryID)
+		}
+
+		categories, err := c.categoryRepository.GetCategoriesByID(ctx, IDs)
+		if err != nil {
+			return nil, fmt.Errorf("get categories by id: %w", err)
+		}
+
+		categoriesByID := make(map[uuid.UUID]models.Category, len(categories))
+		for _, category := range categories {
+			categoriesByID[category.ID] = category
+		}
+
+		for _, activity := range activities {
+			category, exists := categoriesByID[activity.CategoryID]
+			if !exists {
+				continue
+			}
+
+			topCategories = append(topCategories, category.ToTopCategory(activity))
+		}
+	}
+
+	if err := c.cacheService.Set(ctx, topCategoriesKey, topCategories, 2*GetTopCategoriesRefreshInterval()); err != nil {
+		return nil, fmt.Errorf("set top categories to cache: %w", err)
+	}
+
+	return topCategories, nil
+}
+
+func (c *categoryService) GetCategoryBySlug(ctx context.Context, slug string) (*models.CategoryResponse, error) {
+	category, err := c.categoryRepository.GetCategoryBySlug(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("get category by slug %q: %w", slug, err)
+	}
+
+	if category == nil {
+		return nil, models.ErrCategoryNotFound
+	}
+
+	return category.ToCategoryResponse(models.GetLocaleFromContext(ctx)), nil
+}
+
+func (c *categoryService) CreateCategory(ctx context.Context, payload models.CreateCategoryPayload) (*models.CategoryResponse, error) {
+	var parentNormalizedName, parentSlug string
+	if payload.ParentID != nil {
+		parent, err := c.categoryRepository.GetCategoryByID(ctx, *payload.ParentID)
+		if err != nil {
+			return nil, fmt.Errorf("get category by id %q: %w", *payload.ParentID, err)
+		}
+
+		if parent == nil {
+			return nil, models.ErrCategoryNotFound
+		}
+
+		parentNormalizedName = parent.NormalizedName
+		parentSlug = parent.Slug
+	}
+
+	normalizedName := models.ChildCategoryNormalizedName(parentNormalizedName, payload.Name)
+
+	if err := c.ensureCategoryNameAvailable(ctx, normalizedName, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	ID, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("genereate id key: %w", err)
+	}
+
+	category := models.Category{
+		BaseModel: models.BaseModel{
+			ID: ID,
+		},
+		ParentID:       payload.ParentID,
+		Name:           payload.Name,
+		NormalizedName: normalizedName,
+		Translations:   payload.Names,
+	}
+
+	slugName := strings.TrimSpace(parentSlug + " " + payload.Name)
+	for attempt := 1; ; attempt++ {
+		category.Slug, err = c.generateUniqueSlug(ctx, slugName, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		err = c.categoryRepository.CreateCategory(ctx, category)
+		if err == nil {
+			break
+		}
+
+		if !errors.Is(err, models.ErrCategorySlugTaken) || attempt == maxCategorySlugAttempts {
+			return nil, fmt.Errorf("create category: %w", err)
+		}
+	}
+
+	return category.ToCategoryResponse(models.GetLocaleFromContext(ctx)), nil
+}
+
+func (c *categoryService) UpdateCategory(ctx context.Context, ID uuid.UUID, payload models.UpdateCategoryPayload) (*models.CategoryResponse, error) {
+	category, err := c.categoryRepository.GetCategoryByID(ctx, ID)
+	if err != nil {
+		return nil, fmt.Errorf("get category by id %q: %w", ID, err)
+	}
+
+	if category == nil {
+		return nil, models.ErrCategoryNotFound
+	}
+
+	parentID := category.ParentID
+	if payload.MoveToRoot {
+		parentID = nil
+	} else if payload.ParentID != nil {
+		parentID = payload.ParentID
+	}
+
+	var parentNormalizedName string
+	if parentID != nil {
+		if *parentID == ID {
+			return nil, models.ErrInvalidCategoryParent
+		}
+
+		parent, err := c.categoryRepository.GetCategoryByID(ctx, *parentID)
+		if err != nil {
+			return nil, fmt.Errorf("get category by id %q: %w", *parentID, err)
+		}
+
+		if parent == nil {
+			return nil, models.ErrCategoryNotFound
+		}
+
+		if strings.HasPrefix(parent.NormalizedName, category.NormalizedName+models.CategoryPathSeparator) {
+			return nil, models.ErrInvalidCategoryParent
+		}
+
+		parentNormalizedName = parent.NormalizedName
+	}
+
+	previousNormalizedName := category.NormalizedName
+	normalizedName := models.ChildCategoryNormalizedName(parentNormalizedName, payload.Name)
+	if normalizedName != previousNormalizedName {
+		if err := c.ensureCategoryNameAvailable(ctx, normalizedName, ID); err != nil {
+			return nil, err
+		}
+	}
+
+	category.Name = payload.Name
+	category.NormalizedName = normalizedName
+	category.ParentID = parentID
+	if payload.Names != nil {
+		category.Translations = payload.Names
+	}
+
+	if err := c.categoryRepository.UpdateCategory(ctx, *category, previousNormalizedName); err != nil {
+		return nil, fmt.Errorf("update category %q: %w", ID, err)
+	}
+
+	return category.ToCategoryResponse(models.GetLocaleFromContext(ctx)), nil
+}
+
+func (c *categoryService) MergeCategories(ctx context.Context, sourceID uuid.UUID, payload models.MergeCategoryPayload) error {
+	if sourceID == payload.TargetCategoryID {
+		return models.ErrMergeSameCategory
+	}
+
+	source, err := c.categoryRepository.GetCategoryByID(ctx, sourceID)
+	if err != nil {
+		return fmt.Errorf("get category by id %q: %w", sourceID, err)
+	}
+
+	if source == nil {
+		return models.ErrCategoryNotFound
+	}
+
+	totalChildren, err := c.categoryRepository.CountChildrenByCategoryID(ctx, sourceID)
+	if err != nil {
+		return fmt.Errorf("count children by category id %q: %w", sourceID, err)
+	}
+
+	if totalChildren > 0 {
+		return models.ErrCategoryHasChildren
+	}
+
+	target, err := c.categoryRepository.GetCategoryByID(ctx, payload.TargetCategoryID)
+	if err != nil {
+		return fmt.Errorf("get category by id %q: %w", payload.TargetCategoryID, err)
+	}
+
+	if target == nil {
+		return models.ErrCategoryNotFound
+	}
+
+	bookIDs, err := c.bookRepository.GetBookIDsByCategoryID(ctx, sourceID)
+	if err != nil {
+		return fmt.Errorf("get book ids by category id %q: %w", sourceID, err)
+	}
+
+	if err := c.categoryRepository.MergeCategories(ctx, *source, target.ID); err != nil {
+		return fmt.Errorf("merge category %q into %q: %w", sourceID, target.ID, err)
+	}
+
+	if err := c.followRepository.ReassignFollows(ctx, models.CategoryFollowTarget, sourceID, target.ID); err != nil {
+		return fmt.Errorf("reassign follows from category %q to %q: %w", sourceID, target.ID, err)
+	}
+
+	if err := InvalidateSimilarBooks(ctx, c.cacheService, bookIDs); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *categoryService) DeleteCategoryByID(ctx context.Context, ID uuid.UUID) error {
+	category, err := c.categoryRepository.GetCategoryByID(ctx, ID)
+	if err != nil {
+		return fmt.Errorf("get category by id %q: %w", ID, err)
+	}
+
+	if category == nil {
+		return models.ErrCategoryNotFound
+	}
+
+	totalBooks, err := c.categoryRepository.CountBooksByCategoryID(ctx, ID)
+	if err != nil {
+		return fmt.Errorf("count books by category id %q: %w", ID, err)
+	}
+
+	if totalBooks > 0 {
+		return models.ErrCategoryInUse
+	}
+
+	totalChildren, err := c.categoryRepository.CountChildrenByCategoryID(ctx, ID)
+	if err != nil {
+		return fmt.Errorf("count children by category id %q: %w", ID, err)
+	}
+
+	if totalChildren > 0 {
+		return models.ErrCategoryHasChildren
+	}
+
+	if err := c.categoryRepository.DeleteCategoryByID(ctx, ID); err != nil {
+		return fmt.Errorf("delete category by id %q: %w", ID, err)
+	}
+
+	if err := c.followRepository.DeleteFollowsByTarget(ctx, models.CategoryFollowTarget, ID); err != nil {
+		return fmt.Errorf("delete follows of category %q: %w", ID, err)
+	}
+
+	return nil
+}
+
+func (c *categoryService) ensureCategoryNameAvailable(ctx context.Context, normalizedName string, currentID uuid.UUID) error {
+	existing, err := c.categoryRepository.GetCategoryByNormalizedName(ctx, normalizedName)
+	if err != nil {
+		return fmt.Errorf("get category by normalized name: %w", err)
+	}
+
+	if existing != nil && existing.ID != currentID {
+		return models.ErrCategoryAlreadyExists
+	}
+
+	aliases, err := c.categoryRepository.GetCategoryAliases(ctx, []string{normalizedName})
+	if err != nil {
+		return fmt.Errorf("get category aliases: %w", err)
+	}
+
+	for _, alias := range aliases {
+		if alias.CategoryID != currentID {
+			return models.ErrCategoryAlreadyExists
+		}
+	}
+
+	return nil
+}
+
+// createCategories stores the new categories, picking the next free slugs
+// again when a concurrent request claimed one of them in the meantime.
+func (c *categoryService) createCategories(ctx context.Context, categories []models.Category, slugNames []string) error {
+	for attempt := 1; ; attempt++ {
+		reservedSlugs := make(map[string]struct{})
+		for i := range categories {
+			slug, err := c.generateUniqueSlug(ctx, slugNames[i], reservedSlugs)
+			if err != nil {
+				return err
+			}
+			categories[i].Slug = slug
+		}
+
+		err := c.categoryRepository.CreateBatch(ctx, categories)
+		if err == nil {
+			return nil
+		}
+
+		if !errors.Is(err, models.ErrCategorySlugTaken) || attempt == maxCategorySlugAttempts {
+			return fmt.Errorf("create batch: %w", err)
+		}
+	}
+}
+
+func (c *categoryService) generateUniqueSlug(ctx context.Context, name string, reserved map[string]struct{}) (string, error) {
+	base := utils.GenerateSlug(name)
+	if base == "" {
+		base = "categoria"
+	}
+
+	slugs, err := c.categoryRepository.GetSlugsByPrefix(ctx, base)
+	if err != nil {
+		return "", fmt.Errorf("get slugs by prefix %q: %w", base, err)
+	}
+
+	taken := make(map[string]struct{}, len(slugs)+len(reserved))
+	for _, slug := range slugs {
+		taken[slug] = struct{}{}
+	}
+
+	for slug := range reserved {
+		taken[slug] = struct{}{}
+	}
+
+	slug := base
+	for suffix := 2; ; suffix++ {
+		if _, exists := taken[slug]; !exists {
+			break
+		}
+
+		slug = fmt.Sprintf("%s-%d", base, suffix)
+	}
+
+	if reserved != nil {
+		reserved[slug] = struct{}{}
+	}
+
+	return slug, nil
+}
+
+func GetTopCategoriesRefreshInterval() time.Duration {
+	if config.Env.TopCategories.RefreshInterval <= 0 {
+		return defaultTopCategoriesRefreshInterval
+	}
+
+	return time.Duration(config.Env.TopCategories.RefreshInterval) * time.Minute
 }
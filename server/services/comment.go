@@ -0,0 +1,206 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/repositories"
+	"github.com/google/uuid"
+)
+
+type CommentService interface {
+	CreateComment(ctx context.Context, evaluationID uuid.UUID, payload models.CreateCommentPayload) (*models.CommentResponse, error)
+	GetPaginatedComments(ctx context.Context, evaluationID uuid.UUID, pagination *models.Pagination) (*models.PaginatedResponse[*models.CommentResponse], error)
+	UpdateComment(ctx context.Context, evaluationID, ID uuid.UUID, payload models.UpdateCommentPayload) (*models.CommentResponse, error)
+	DeleteComment(ctx context.Context, evaluationID, ID uuid.UUID) error
+}
+
+type commentService struct {
+	di                   *internal.Di
+	notificationService  NotificationService
+	commentRepository    repositories.CommentRepository
+	evaluationRepository repositories.EvaluationRepository
+	userRepository       repositories.UserRepository
+}
+
+func NewCommentService(di *internal.Di) (CommentService, error) {
+	notificationService, err := internal.Invoke[NotificationService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	commentRepository, err := internal.Invoke[repositories.CommentRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	evaluationRepository, err := internal.Invoke[repositories.EvaluationRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	userRepository, err := internal.Invoke[repositories.UserRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &commentService{
+		di:                   di,
+		notificationService:  notificationService,
+		commentRepository:    commentRepository,
+		evaluationRepository: evaluationRepository,
+		userRepository:       userRepository,
+	}, nil
+}
+
+func (c *commentService) CreateComment(ctx context.Context, evaluationID uuid.UUID, payload models.CreateCommentPayload) (*models.CommentResponse, error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	evaluation, err := c.evaluationRepository.GetEvaluationByID(ctx, evaluationID)
+	if err != nil {
+		return nil, fmt.Errorf("get evaluation by id %q: %w", evaluationID, err)
+	}
+
+	if evaluation == nil || !evaluation.IsVisibleTo(session.UserID) {
+		return nil, models.ErrEvaluationNotFound
+	}
+
+	if payload.ParentID != nil {
+		parent, err := c.commentRepository.GetCommentByID(ctx, *payload.ParentID)
+		if err != nil {
+			return nil, fmt.Errorf("get comment by id %q: %w", *payload.ParentID, err)
+		}
+
+		if parent == nil || parent.EvaluationID != evaluationID || parent.ParentID != nil {
+			return nil, models.ErrInvalidCommentParent
+		}
+	}
+
+	user, err := c.userRepository.GetUserByID(ctx, session.UserID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("get user by id %q: %w", session.UserID, err)
+	}
+
+	if user == nil {
+		return nil, models.ErrUserNotFound
+	}
+
+	comment := payload.ToComment(evaluationID, session.UserID)
+	if err := c.commentRepository.CreateComment(ctx, comment); err != nil {
+		return nil, fmt.Errorf("create comment: %w", err)
+	}
+
+	if evaluation.UserID != session.UserID {
+		task := models.NotificationTask{
+			UserIDs: []uuid.UUID{evaluation.UserID},
+			Type:    models.EvaluationCommentNotification,
+			BookID:  &evaluation.BookID,
+			Data: map[string]string{
+				"evaluationId":      evaluationID.String(),
+				"commentId":         comment.ID.String(),
+				"commenterFullName": user.ToPublicUserResponse(evaluation.UserID).FullName,
+			},
+		}
+
+		if err := c.notificationService.Notify(task); err != nil {
+			slog.Error(err.Error())
+		}
+	}
+
+	comment.User = *user
+	return comment.ToCommentResponse(session.UserID), nil
+}
+
+func (c *commentService) GetPaginatedComments(ctx context.Context, evaluationID uuid.UUID, pagination *models.Pagination) (*models.PaginatedResponse[*models.CommentResponse], error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	evaluation, err := c.evaluationRepository.GetEvaluationByID(ctx, evaluationID)
+	if err != nil {
+		return nil, fmt.Errorf("get evaluation by id %q: %w", evaluationID, err)
+	}
+
+	if evaluation == nil || !evaluation.IsVisibleTo(session.UserID) {
+		return nil, models.ErrEvaluationNotFound
+	}
+
+	paginatedComments, err := c.commentRepository.GetPaginatedCommentsByEvaluationID(ctx, evaluationID, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("get paginated comments by evaluation id %q: %w", evaluationID, err)
+	}
+
+	paginatedCommentsResponse := models.MapPaginatedResult(paginatedComments, func(comment models.Comment) *models.CommentResponse {
+		return comment.ToCommentResponse(session.UserID)
+	})
+
+	return paginatedCommentsResponse, nil
+}
+
+func (c *commentService) UpdateComment(ctx context.Context, evaluationID, ID uuid.UUID, payload models.UpdateCommentPayload) (*models.CommentResponse, error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	comment, err := c.getEvaluationComment(ctx, evaluationID, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.UserID != session.UserID {
+		return nil, models.ErrCommentNotOwned
+	}
+
+	comment.Content = payload.Content
+	comment.UpdatedAt = sql.NullTime{Time: time.Now().UTC(), Valid: true}
+	if err := c.commentRepository.UpdateComment(ctx, comment); err != nil {
+		return nil, fmt.Errorf("update comment %q: %w", ID, err)
+	}
+
+	return comment.ToCommentResponse(session.UserID), nil
+}
+
+func (c *commentService) DeleteComment(ctx context.Context, evaluationID, ID uuid.UUID) error {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return models.ErrUserNotFoundInContext
+	}
+
+	comment, err := c.getEvaluationComment(ctx, evaluationID, ID)
+	if err != nil {
+		return err
+	}
+
+	if comment.UserID != session.UserID && !models.CheckPermission(session.Role, models.DeleteCommentPermission) {
+		return models.ErrCommentNotOwned
+	}
+
+	if err := c.commentRepository.DeleteCommentByID(ctx, ID); err != nil {
+		return fmt.Errorf("delete comment %q: %w", ID, err)
+	}
+
+	return nil
+}
+
+func (c *commentService) getEvaluationComment(ctx context.Context, evaluationID, ID uuid.UUID) (*models.Comment, error) {
+	comment, err := c.commentRepository.GetCommentByID(ctx, ID)
+	if err != nil {
+		return nil, fmt.Errorf("get comment by id %q: %w", ID, err)
+	}
+
+	if comment == nil || comment.EvaluationID != evaluationID {
+		return nil, models.ErrCommentNotFound
+	}
+
+	return comment, nil
+}
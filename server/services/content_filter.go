@@ -0,0 +1,210 @@
+package services
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/G-Villarinho/book-wise-api/config"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/utils"
+)
+
+const (
+	defaultContentFilterMaxLinks      = 1
+	defaultContentFilterMaxRepetition = 5
+	minLettersForUppercaseCheck       = 20
+	maxUppercaseRatio                 = 0.7
+)
+
+var linkPattern = regexp.MustCompile(`(?i)(https?://|www\.)\S+|\b[a-z0-9-]+\.(com|net|org|io|br|info|xyz|ru|ly)\b`)
+
+type ContentFilter interface {
+	Check(text string) (models.ContentFlag, bool)
+}
+
+type ContentFilterService interface {
+	Check(text string) []models.ContentFlag
+}
+
+type contentFilterService struct {
+	di      *internal.Di
+	filters []ContentFilter
+}
+
+func NewContentFilterService(di *internal.Di) (ContentFilterService, error) {
+	return &contentFilterService{
+		di: di,
+		filters: []ContentFilter{
+			NewBannedWordsFilter(config.Env.ContentFilter.BannedWords),
+			NewLinkSpamFilter(GetContentFilterMaxLinks()),
+			NewShoutingFilter(),
+			NewRepetitionFilter(GetContentFilterMaxRepetition()),
+		},
+	}, nil
+}
+
+func (c *contentFilterService) Check(text string) []models.ContentFlag {
+	var flags []models.ContentFlag
+	for _, filter := range c.filters {
+		if flag, flagged := filter.Check(text); flagged {
+			flags = append(flags, flag)
+		}
+	}
+
+	return flags
+}
+
+type bannedWordsFilter struct {
+	words   map[string]bool
+	phrases []string
+}
+
+func NewBannedWordsFilter(bannedWords []string) ContentFilter {
+	filter := &bannedWordsFilter{words: make(map[string]bool)}
+	for _, bannedWord := range bannedWords {
+		normalized := normalizeContent(bannedWord)
+		if normalized == "" {
+			continue
+		}
+
+		if strings.Contains(normalized, " ") {
+			filter.phrases = append(filter.phrases, " "+normalized+" ")
+			continue
+		}
+
+		filter.words[normalized] = true
+	}
+
+	return filter
+}
+
+func (b *bannedWordsFilter) Check(text string) (models.ContentFlag, bool) {
+	words := strings.Fields(normalizeContent(text))
+	for _, word := range words {
+		if b.words[word] {
+			return models.BannedWordContentFlag, true
+		}
+	}
+
+	joined := " " + strings.Join(words, " ") + " "
+	for _, phrase := range b.phrases {
+		if strings.Contains(joined, phrase) {
+			return models.BannedWordContentFlag, true
+		}
+	}
+
+	return "", false
+}
+
+type linkSpamFilter struct {
+	maxLinks int
+}
+
+func NewLinkSpamFilter(maxLinks int) ContentFilter {
+	return &linkSpamFilter{maxLinks: maxLinks}
+}
+
+func (l *linkSpamFilter) Check(text string) (models.ContentFlag, bool) {
+	if len(linkPattern.FindAllString(text, -1)) > l.maxLinks {
+		return models.LinkSpamContentFlag, true
+	}
+
+	return "", false
+}
+
+type shoutingFilter struct{}
+
+func NewShoutingFilter() ContentFilter {
+	return &shoutingFilter{}
+}
+
+func (s *shoutingFilter) Check(text string) (models.ContentFlag, bool) {
+	var letters, uppercase int
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+
+		letters++
+		if unicode.IsUpper(r) {
+			uppercase++
+		}
+	}
+
+	if letters >= minLettersForUppercaseCheck && float64(uppercase)/float64(letters) > maxUppercaseRatio {
+		return models.ShoutingContentFlag, true
+	}
+
+	return "", false
+}
+
+type repetitionFilter struct {
+	maxRepetition int
+}
+
+func NewRepetitionFilter(maxRepetition int) ContentFilter {
+	return &repetitionFilter{maxRepetition: maxRepetition}
+}
+
+func (r *repetitionFilter) Check(text string) (models.ContentFlag, bool) {
+	var last rune
+	count := 0
+	for _, char := range strings.ToLower(text) {
+		if char == last && !unicode.IsSpace(char) {
+			count++
+		} else {
+			last, count = char, 1
+		}
+
+		if count > r.maxRepetition {
+			return models.RepetitionContentFlag, true
+		}
+	}
+
+	var lastWord string
+	count = 0
+	for _, word := range strings.Fields(utils.NormalizeString(text)) {
+		if word == lastWord {
+			count++
+		} else {
+			lastWord, count = word, 1
+		}
+
+		if count > r.maxRepetition {
+			return models.RepetitionContentFlag, true
+		}
+	}
+
+	return "", false
+}
+
+// normalizeContent lowercases the text, undoes leetspeak and accents, and turns
+// everything that isn't a letter or digit into a single space, so words glued
+// together by punctuation ("idiota,você") are still checked one by one.
+func normalizeContent(text string) string {
+	normalized := utils.RemoveAccents(utils.NormalizeLeetspeak(strings.ToLower(text)))
+
+	words := strings.FieldsFunc(normalized, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	return strings.Join(words, " ")
+}
+
+func GetContentFilterMaxLinks() int {
+	if config.Env.ContentFilter.MaxLinks <= 0 {
+		return defaultContentFilterMaxLinks
+	}
+
+	return config.Env.ContentFilter.MaxLinks
+}
+
+func GetContentFilterMaxRepetition() int {
+	if config.Env.ContentFilter.MaxRepetition <= 0 {
+		return defaultContentFilterMaxRepetition
+	}
+
+	return config.Env.ContentFilter.MaxRepetition
+}
@@ -19,3 +19,33 @@ func (f *EmailFactory) CreateSignInMagicLinkEmail(to string, name string, magicL
 		},
 	}
 }
+
+func (f *EmailFactory) CreateNewBooksDigestEmail(to string, name string, books string, booksLink string) models.EmailQueueTask {
+	return models.EmailQueueTask{
+		To:       []string{to},
+		Subject:  "New books from what you follow",
+		Template: models.NewBooksDigest,
+		Params: map[string]string{
+			"name":       name,
+			"books":      books,
+			"books_link": booksLink,
+		},
+	}
+}
+
+func (f *EmailFactory) CreateReadingChallengeSummaryEmail(to string, name string, year string, goal string, booksFinished string, result string, books string, challengeLink string) models.EmailQueueTask {
+	return models.EmailQueueTask{
+		To:       []string{to},
+		Subject:  "Your " + year + " reading challenge",
+		Template: models.ReadingChallengeSummary,
+		Params: map[string]string{
+			"name":           name,
+			"year":           year,
+			"goal":           goal,
+			"books_finished": booksFinished,
+			"result":         result,
+			"books":          books,
+			"challenge_link": challengeLink,
+		},
+	}
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"log/slog"
 
 	"github.com/G-Villarinho/book-wise-api/internal"
 	"github.com/G-Villarinho/book-wise-api/models"
@@ -13,16 +14,30 @@ import (
 type EvaluationService interface {
 	CreateEvaluation(ctx context.Context, bookID uuid.UUID, payload models.CreateEvaluationPayload) (*models.EvaluationBasicInfoResponse, error)
 	GetPaginatedEvaluationsByBookID(ctx context.Context, bookID uuid.UUID, pagination *models.Pagination) (*models.PaginatedResponse[*models.EvaluationBasicInfoResponse], error)
+	LikeEvaluation(ctx context.Context, ID uuid.UUID) (*models.EvaluationLikeResponse, error)
+	UnlikeEvaluation(ctx context.Context, ID uuid.UUID) (*models.EvaluationLikeResponse, error)
 }
 
 type evaluationService struct {
 	di                   *internal.Di
+	contentFilterService ContentFilterService
+	feedService          FeedService
 	bookRepository       repositories.BookRepository
 	evaluationRepository repositories.EvaluationRepository
 	userRepository       repositories.UserRepository
 }
 
 func NewEvaluationService(di *internal.Di) (EvaluationService, error) {
+	contentFilterService, err := internal.Invoke[ContentFilterService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	feedService, err := internal.Invoke[FeedService](di)
+	if err != nil {
+		return nil, err
+	}
+
 	bookRepository, err := internal.Invoke[repositories.BookRepository](di)
 	if err != nil {
 		return nil, err
@@ -40,6 +55,8 @@ func NewEvaluationService(di *internal.Di) (EvaluationService, error) {
 
 	return &evaluationService{
 		di:                   di,
+		contentFilterService: contentFilterService,
+		feedService:          feedService,
 		bookRepository:       bookRepository,
 		evaluationRepository: evaluationRepository,
 		userRepository:       userRepository,
@@ -71,12 +88,22 @@ func (e *evaluationService) CreateEvaluation(ctx context.Context, bookID uuid.UU
 	}
 
 	evaluation = payload.ToEvaluation(session.UserID, bookID)
+	if flags := e.contentFilterService.Check(evaluation.Description); len(flags) > 0 {
+		evaluation.HoldForModeration(flags)
+	}
+
 	if err := e.evaluationRepository.CreateEvaluation(ctx, *evaluation); err != nil {
 		return nil, fmt.Errorf("create evaluation: %w", err)
 	}
 
+	if evaluation.Status == models.VisibleEvaluation {
+		if err := e.feedService.RecordActivity(ctx, models.NewEvaluationCreatedActivity(evaluation)); err != nil {
+			slog.Error(err.Error())
+		}
+	}
+
 	evaluation.User = *user
-	return evaluation.ToEvaluationBasicInfoResponse(), nil
+	return evaluation.ToEvaluationBasicInfoResponse(session.UserID), nil
 }
 
 func (e *evaluationService) GetPaginatedEvaluationsByBookID(ctx context.Context, bookID uuid.UUID, pagination *models.Pagination) (*models.PaginatedResponse[*models.EvaluationBasicInfoResponse], error) {
@@ -90,9 +117,90 @@ func (e *evaluationService) GetPaginatedEvaluationsByBookID(ctx context.Context,
 		return nil, fmt.Errorf("get paginated evaluations by book id %q: %w", bookID, err)
 	}
 
+	evaluationIDs := make([]uuid.UUID, 0, len(paginatedPublishedEvaluations.Data))
+	for _, evaluation := range paginatedPublishedEvaluations.Data {
+		evaluationIDs = append(evaluationIDs, evaluation.ID)
+	}
+
+	likedIDs, err := e.evaluationRepository.GetLikedEvaluationIDs(ctx, session.UserID, evaluationIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get evaluations liked by user %q: %w", session.UserID, err)
+	}
+
+	liked := make(map[uuid.UUID]bool, len(likedIDs))
+	for _, ID := range likedIDs {
+		liked[ID] = true
+	}
+
 	paginatedPublishedEvaluationsResponse := models.MapPaginatedResult(paginatedPublishedEvaluations, func(evaluation models.Evaluation) *models.EvaluationBasicInfoResponse {
-		return evaluation.ToEvaluationBasicInfoResponse()
+		response := evaluation.ToEvaluationBasicInfoResponse(session.UserID)
+		response.LikedByMe = liked[evaluation.ID]
+		return response
 	})
 
 	return paginatedPublishedEvaluationsResponse, nil
 }
+
+func (e *evaluationService) LikeEvaluation(ctx context.Context, ID uuid.UUID) (*models.EvaluationLikeResponse, error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	evaluation, err := e.evaluationRepository.GetEvaluationByID(ctx, ID)
+	if err != nil {
+		return nil, fmt.Errorf("get evaluation by id %q: %w", ID, err)
+	}
+
+	if evaluation == nil || !evaluation.IsVisibleTo(session.UserID) {
+		return nil, models.ErrEvaluationNotFound
+	}
+
+	if evaluation.UserID == session.UserID {
+		return nil, models.ErrCannotLikeOwnEvaluation
+	}
+
+	if err := e.evaluationRepository.LikeEvaluation(ctx, models.NewEvaluationLike(ID, session.UserID)); err != nil {
+		return nil, fmt.Errorf("like evaluation %q: %w", ID, err)
+	}
+
+	return e.getEvaluationLikes(ctx, ID, true)
+}
+
+func (e *evaluationService) UnlikeEvaluation(ctx context.Context, ID uuid.UUID) (*models.EvaluationLikeResponse, error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	evaluation, err := e.evaluationRepository.GetEvaluationByID(ctx, ID)
+	if err != nil {
+		return nil, fmt.Errorf("get evaluation by id %q: %w", ID, err)
+	}
+
+	if evaluation == nil || !evaluation.IsVisibleTo(session.UserID) {
+		return nil, models.ErrEvaluationNotFound
+	}
+
+	if err := e.evaluationRepository.UnlikeEvaluation(ctx, ID, session.UserID); err != nil {
+		return nil, fmt.Errorf("unlike evaluation %q: %w", ID, err)
+	}
+
+	return e.getEvaluationLikes(ctx, ID, false)
+}
+
+func (e *evaluationService) getEvaluationLikes(ctx context.Context, ID uuid.UUID, likedByMe bool) (*models.EvaluationLikeResponse, error) {
+	evaluation, err := e.evaluationRepository.GetEvaluationByID(ctx, ID)
+	if err != nil {
+		return nil, fmt.Errorf("get evaluation by id %q: %w", ID, err)
+	}
+
+	if evaluation == nil {
+		return nil, models.ErrEvaluationNotFound
+	}
+
+	return &models.EvaluationLikeResponse{
+		Likes:     evaluation.Likes,
+		LikedByMe: likedByMe,
+	}, nil
+}
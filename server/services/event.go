@@ -0,0 +1,96 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/G-Villarinho/book-wise-api/cache"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/google/uuid"
+)
+
+type EventService interface {
+	PublishToUser(ctx context.Context, userID uuid.UUID, eventType models.EventType, data any) error
+	PublishToRoles(ctx context.Context, roles []models.Role, eventType models.EventType, data any) error
+	PublishToAll(ctx context.Context, eventType models.EventType, data any) error
+	Subscribe(ctx context.Context) (<-chan []byte, error)
+}
+
+const (
+	broadcastEventsChannel = "events:broadcast"
+)
+
+type eventService struct {
+	di           *internal.Di
+	cacheService cache.CacheService
+}
+
+func NewEventService(di *internal.Di) (EventService, error) {
+	cacheService, err := internal.Invoke[cache.CacheService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventService{
+		di:           di,
+		cacheService: cacheService,
+	}, nil
+}
+
+func (e *eventService) PublishToUser(ctx context.Context, userID uuid.UUID, eventType models.EventType, data any) error {
+	return e.publish(ctx, getUserEventsChannel(userID), eventType, data)
+}
+
+func (e *eventService) PublishToRoles(ctx context.Context, roles []models.Role, eventType models.EventType, data any) error {
+	for _, role := range roles {
+		if err := e.publish(ctx, getRoleEventsChannel(role), eventType, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *eventService) PublishToAll(ctx context.Context, eventType models.EventType, data any) error {
+	return e.publish(ctx, broadcastEventsChannel, eventType, data)
+}
+
+func (e *eventService) Subscribe(ctx context.Context) (<-chan []byte, error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	messages, err := e.cacheService.Subscribe(ctx,
+		broadcastEventsChannel,
+		getRoleEventsChannel(session.Role),
+		getUserEventsChannel(session.UserID),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("subscribe to events of user %q: %w", session.UserID, err)
+	}
+
+	return messages, nil
+}
+
+func (e *eventService) publish(ctx context.Context, channel string, eventType models.EventType, data any) error {
+	event, err := models.NewEvent(eventType, data)
+	if err != nil {
+		return fmt.Errorf("create %s event: %w", eventType, err)
+	}
+
+	if err := e.cacheService.Publish(ctx, channel, event); err != nil {
+		return fmt.Errorf("publish %s event to %s: %w", eventType, channel, err)
+	}
+
+	return nil
+}
+
+func getUserEventsChannel(userID uuid.UUID) string {
+	return fmt.Sprintf("events:user:%s", userID.String())
+}
+
+func getRoleEventsChannel(role models.Role) string {
+	return fmt.Sprintf("events:role:%s", role)
+}
@@ -0,0 +1,279 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/G-Villarinho/book-wise-api/cache"
+	"github.com/G-Villarinho/book-wise-api/config"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/repositories"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+)
+
+const (
+	defaultFeedActiveDays = 7
+	defaultFeedMaxSize    = 500
+)
+
+type FeedService interface {
+	RecordActivity(ctx context.Context, activity *models.Activity) error
+	FanOutActivity(ctx context.Context, task models.ActivityTask) (int, error)
+	GetFeed(ctx context.Context, pagination *models.FeedPagination) (*models.FeedResponse, error)
+}
+
+type feedService struct {
+	di                 *internal.Di
+	cacheService       cache.CacheService
+	queueService       QueueService
+	activityRepository repositories.ActivityRepository
+	followRepository   repositories.FollowRepository
+}
+
+func NewFeedService(di *internal.Di) (FeedService, error) {
+	cacheService, err := internal.Invoke[cache.CacheService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	queueService, err := internal.Invoke[QueueService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	activityRepository, err := internal.Invoke[repositories.ActivityRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	followRepository, err := internal.Invoke[repositories.FollowRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feedService{
+		di:                 di,
+		cacheService:       cacheService,
+		queueService:       queueService,
+		activityRepository: activityRepository,
+		followRepository:   followRepository,
+	}, nil
+}
+
+func (f *feedService) RecordActivity(ctx context.Context, activity *models.Activity) error {
+	if err := f.activityRepository.CreateActivity(ctx, activity); err != nil {
+		return fmt.Errorf("create %s activity for user %q: %w", activity.Type, activity.ActorID, err)
+	}
+
+	message, err := jsoniter.Marshal(models.ActivityTask{
+		ActivityID: activity.ID,
+		ActorID:    activity.ActorID,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal activity task: %w", err)
+	}
+
+	if err := f.queueService.Publish(FanOutActivities, message); err != nil {
+		return fmt.Errorf("publish activity task: %w", err)
+	}
+
+	return nil
+}
+
+// FanOutActivity pushes the activity into the cached feed of every follower
+// that has one. Followers without a cached feed are not active and will read
+// their feed from the database until they come back.
+func (f *feedService) FanOutActivity(ctx context.Context, task models.ActivityTask) (int, error) {
+	followerIDs, err := f.followRepository.GetFollowerIDs(ctx, models.UserFollowTarget, task.ActorID)
+	if err != nil {
+		return 0, fmt.Errorf("get followers of user %q: %w", task.ActorID, err)
+	}
+
+	pushed := 0
+	for _, followerID := range followerIDs {
+		ok, err := f.cacheService.PushToSortedSetIfExists(ctx, getFeedKey(followerID), task.ActivityID.String(), int64(GetFeedMaxSize()))
+		if err != nil {
+			return pushed, fmt.Errorf("push activity %q to feed of user %q: %w", task.ActivityID, followerID, err)
+		}
+
+		if ok {
+			pushed++
+		}
+	}
+
+	return pushed, nil
+}
+
+func (f *feedService) GetFeed(ctx context.Context, pagination *models.FeedPagination) (*models.FeedResponse, error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	activities, nextCursor, found, err := f.getCachedFeed(ctx, session.UserID, pagination)
+	if err != nil {
+		slog.Error(err.Error())
+	}
+
+	if !found {
+		activities, nextCursor, err = f.getStoredFeed(ctx, session.UserID, pagination)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	response := &models.FeedResponse{
+		Data: make([]*models.ActivityResponse, 0, len(activities)),
+	}
+
+	for _, activity := range activities {
+		if activity.IsViewableBy(session.UserID) {
+			response.Data = append(response.Data, activity.ToActivityResponse(session.UserID))
+		}
+	}
+
+	if nextCursor != nil {
+		cursor := nextCursor.String()
+		response.NextCursor = &cursor
+	}
+
+	return response, nil
+}
+
+// getCachedFeed reads the page from the user's cached feed. It reports false
+// when the page has to come from the database instead: the user has no cached
+// feed or the page goes past the activities the cache still retains.
+func (f *feedService) getCachedFeed(ctx context.Context, userID uuid.UUID, pagination *models.FeedPagination) ([]models.Activity, *uuid.UUID, bool, error) {
+	key := getFeedKey(userID)
+
+	exists, err := f.cacheService.Exists(ctx, key)
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("check feed cache of user %q: %w", userID, err)
+	}
+
+	if !exists {
+		return nil, nil, false, nil
+	}
+
+	if err := f.cacheService.Expire(ctx, key, GetFeedActiveWindow()); err != nil {
+		return nil, nil, false, fmt.Errorf("refresh feed cache of user %q: %w", userID, err)
+	}
+
+	var before string
+	if pagination.Cursor != nil {
+		before = pagination.Cursor.String()
+	}
+
+	members, err := f.cacheService.GetSortedSetMembersBefore(ctx, key, before, int64(pagination.Limit+1))
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("get feed cache of user %q: %w", userID, err)
+	}
+
+	if len(members) <= pagination.Limit {
+		size, err := f.cacheService.GetSortedSetSize(ctx, key)
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("get feed cache size of user %q: %w", userID, err)
+		}
+
+		if size >= int64(GetFeedMaxSize()) {
+			return nil, nil, false, nil
+		}
+	}
+
+	IDs := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		ID, err := uuid.Parse(member)
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("parse feed cache member %q: %w", member, err)
+		}
+		IDs = append(IDs, ID)
+	}
+
+	var nextCursor *uuid.UUID
+	if len(IDs) > pagination.Limit {
+		nextCursor = &IDs[pagination.Limit-1]
+		IDs = IDs[:pagination.Limit]
+	}
+
+	activities, err := f.activityRepository.GetActivitiesByIDs(ctx, IDs)
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("get activities by ids: %w", err)
+	}
+
+	return activities, nextCursor, true, nil
+}
+
+func (f *feedService) getStoredFeed(ctx context.Context, userID uuid.UUID, pagination *models.FeedPagination) ([]models.Activity, *uuid.UUID, error) {
+	activities, err := f.activityRepository.GetFeedActivities(ctx, userID, pagination.Cursor, pagination.Limit+1)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get feed activities of user %q: %w", userID, err)
+	}
+
+	var nextCursor *uuid.UUID
+	if len(activities) > pagination.Limit {
+		nextCursor = &activities[pagination.Limit-1].ID
+		activities = activities[:pagination.Limit]
+	}
+
+	if pagination.Cursor == nil {
+		if err := f.warmFeed(ctx, userID); err != nil {
+			slog.Error(err.Error())
+		}
+	}
+
+	return activities, nextCursor, nil
+}
+
+// warmFeed caches the latest activities of the followed users, which makes the
+// user active and lets new activities be fanned out to them on write.
+func (f *feedService) warmFeed(ctx context.Context, userID uuid.UUID) error {
+	IDs, err := f.activityRepository.GetFeedActivityIDs(ctx, userID, GetFeedMaxSize())
+	if err != nil {
+		return fmt.Errorf("get feed activity ids of user %q: %w", userID, err)
+	}
+
+	members := make([]string, len(IDs))
+	for i, ID := range IDs {
+		members[i] = ID.String()
+	}
+
+	if err := f.cacheService.ReplaceSortedSet(ctx, getFeedKey(userID), members, GetFeedActiveWindow()); err != nil {
+		return fmt.Errorf("cache feed of user %q: %w", userID, err)
+	}
+
+	return nil
+}
+
+func getFeedKey(userID uuid.UUID) string {
+	return fmt.Sprintf("feed:%s", userID.String())
+}
+
+// InvalidateFeed drops the cached feed so it is rebuilt from the database on
+// the next read, e.g. after the user follows or unfollows someone.
+func InvalidateFeed(ctx context.Context, cacheService cache.CacheService, userID uuid.UUID) error {
+	if err := cacheService.Delete(ctx, getFeedKey(userID)); err != nil {
+		return fmt.Errorf("delete feed cache of user %q: %w", userID, err)
+	}
+
+	return nil
+}
+
+func GetFeedActiveWindow() time.Duration {
+	if config.Env.Feed.ActiveDays <= 0 {
+		return defaultFeedActiveDays * 24 * time.Hour
+	}
+
+	return time.Duration(config.Env.Feed.ActiveDays) * 24 * time.Hour
+}
+
+func GetFeedMaxSize() int {
+	if config.Env.Feed.MaxSize <= 0 {
+		return defaultFeedMaxSize
+	}
+
+	return config.Env.Feed.MaxSize
+}
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/G-Villarinho/book-wise-api/cache"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/repositories"
+	"github.com/google/uuid"
+)
+
+type FollowService interface {
+	Follow(ctx context.Context, targetType models.FollowTargetType, targetID uuid.UUID, payload models.FollowPayload) (*models.FollowResponse, error)
+	Unfollow(ctx context.Context, targetType models.FollowTargetType, targetID uuid.UUID) error
+}
+
+type followService struct {
+	di                 *internal.Di
+	cacheService       cache.CacheService
+	authorRepository   repositories.AuthorRepository
+	categoryRepository repositories.CategoryRepository
+	followRepository   repositories.FollowRepository
+	userRepository     repositories.UserRepository
+}
+
+func NewFollowService(di *internal.Di) (FollowService, error) {
+	cacheService, err := internal.Invoke[cache.CacheService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	authorRepository, err := internal.Invoke[repositories.AuthorRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryRepository, err := internal.Invoke[repositories.CategoryRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	followRepository, err := internal.Invoke[repositories.FollowRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	userRepository, err := internal.Invoke[repositories.UserRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &followService{
+		di:                 di,
+		cacheService:       cacheService,
+		authorRepository:   authorRepository,
+		categoryRepository: categoryRepository,
+		followRepository:   followRepository,
+		userRepository:     userRepository,
+	}, nil
+}
+
+func (f *followService) Follow(ctx context.Context, targetType models.FollowTargetType, targetID uuid.UUID, payload models.FollowPayload) (*models.FollowResponse, error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	if targetType == models.UserFollowTarget && targetID == session.UserID {
+		return nil, models.ErrCannotFollowYourself
+	}
+
+	if err := f.ensureTargetExists(ctx, targetType, targetID); err != nil {
+		return nil, err
+	}
+
+	follow, err := f.followRepository.GetFollow(ctx, session.UserID, targetType, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("get user %q follow of %s %q: %w", session.UserID, targetType, targetID, err)
+	}
+
+	now := time.Now().UTC()
+	if follow == nil {
+		ID, _ := uuid.NewV7()
+		follow = &models.Follow{
+			BaseModel: models.BaseModel{
+				ID:        ID,
+				CreatedAt: now,
+			},
+			UserID:     session.UserID,
+			TargetType: targetType,
+			TargetID:   targetID,
+		}
+	}
+
+	follow.NotifyByEmail = payload.NotifyByEmail
+	if err := f.followRepository.SaveFollow(ctx, follow); err != nil {
+		return nil, fmt.Errorf("save user %q follow of %s %q: %w", session.UserID, targetType, targetID, err)
+	}
+
+	if targetType == models.UserFollowTarget {
+		if err := InvalidateFeed(ctx, f.cacheService, session.UserID); err != nil {
+			slog.Error(err.Error())
+		}
+	}
+
+	return follow.ToFollowResponse(), nil
+}
+
+func (f *followService) Unfollow(ctx context.Context, targetType models.FollowTargetType, targetID uuid.UUID) error {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return models.ErrUserNotFoundInContext
+	}
+
+	follow, err := f.followRepository.GetFollow(ctx, session.UserID, targetType, targetID)
+	if err != nil {
+		return fmt.Errorf("get user %q follow of %s %q: %w", session.UserID, targetType, targetID, err)
+	}
+
+	if follow == nil {
+		return models.ErrFollowNotFound
+	}
+
+	if err := f.followRepository.DeleteFollow(ctx, session.UserID, targetType, targetID); err != nil {
+		return fmt.Errorf("delete user %q follow of %s %q: %w", session.UserID, targetType, targetID, err)
+	}
+
+	if targetType == models.UserFollowTarget {
+		if err := InvalidateFeed(ctx, f.cacheService, session.UserID); err != nil {
+			slog.Error(err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (f *followService) ensureTargetExists(ctx context.Context, targetType models.FollowTargetType, targetID uuid.UUID) error {
+	switch targetType {
+	case models.AuthorFollowTarget:
+		author, err := f.authorRepository.GetAuthorByID(ctx, targetID)
+		if err != nil {
+			return fmt.Errorf("get author by id %q: %w", targetID, err)
+		}
+
+		if author == nil {
+			return models.ErrAuthorNotFound
+		}
+	case models.CategoryFollowTarget:
+		category, err := f.categoryRepository.GetCategoryByID(ctx, targetID)
+		if err != nil {
+			return fmt.Errorf("get category by id %q: %w", targetID, err)
+		}
+
+		if category == nil {
+			return models.ErrCategoryNotFound
+		}
+	case models.UserFollowTarget:
+		user, err := f.userRepository.GetUserByID(ctx, targetID, []models.Role{models.Member})
+		if err != nil {
+			return fmt.Errorf("get user by id %q: %w", targetID, err)
+		}
+
+		if user == nil || user.HideProfile {
+			return models.ErrUserNotFound
+		}
+	}
+
+	return nil
+}
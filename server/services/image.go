@@ -2,10 +2,17 @@ package services
 
 import (
 	"context"
+	"fmt"
+	"log/slog"
+	"time"
 
-	"github.com/G-Villarinho/book-wise-api/clients"
 	"github.com/G-Villarinho/book-wise-api/internal"
 	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/repositories"
+	"github.com/G-Villarinho/book-wise-api/storage"
+	"github.com/G-Villarinho/book-wise-api/utils"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
 )
 
 type ProcessorQueue string
@@ -17,31 +24,158 @@ const (
 
 type ImageService interface {
 	UploadImage(ctx context.Context, uploadImageName string, task models.ImageUploadTask) (*models.UploadImageResponse, error)
+	UploadImageVariants(ctx context.Context, uploadImageName string, kind models.ImageKind, image []byte) (models.ImageVariants, error)
+	DeleteImage(ctx context.Context, imageID uuid.UUID) error
+	ScheduleImageDeletion(imageIDs []uuid.UUID) error
+	DeleteOrphanImages(ctx context.Context, safetyWindow time.Duration) (int, error)
+	GetImageURL(imageID uuid.UUID) string
 }
 
 type imageService struct {
-	di                *internal.Di
-	imageUploadClient clients.CloudFlareImageClient
+	di              *internal.Di
+	imageStorage    storage.ImageStorage
+	queueService    QueueService
+	imageRepository repositories.ImageRepository
 }
 
 func NewImageService(di *internal.Di) (ImageService, error) {
-	imageUploadClient, err := internal.Invoke[clients.CloudFlareImageClient](di)
+	imageStorage, err := internal.Invoke[storage.ImageStorage](di)
+	if err != nil {
+		return nil, err
+	}
+
+	queueService, err := internal.Invoke[QueueService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	imageRepository, err := internal.Invoke[repositories.ImageRepository](di)
 	if err != nil {
 		return nil, err
 	}
 
 	return &imageService{
-		di:                di,
-		imageUploadClient: imageUploadClient,
+		di:              di,
+		imageStorage:    imageStorage,
+		queueService:    queueService,
+		imageRepository: imageRepository,
 	}, nil
 
 }
 
 func (i *imageService) UploadImage(ctx context.Context, uploadImageName string, task models.ImageUploadTask) (*models.UploadImageResponse, error) {
-	uploadImageResponse, err := i.imageUploadClient.UploadImage(task.Image, uploadImageName)
+	uploadImageResponse, err := i.imageStorage.Upload(ctx, task.Image, uploadImageName)
 	if err != nil {
 		return nil, err
 	}
 
 	return uploadImageResponse, nil
 }
+
+func (i *imageService) UploadImageVariants(ctx context.Context, uploadImageName string, kind models.ImageKind, image []byte) (models.ImageVariants, error) {
+	decoded, err := utils.DecodeImage(image)
+	if err != nil {
+		return nil, err
+	}
+
+	variants := make(models.ImageVariants)
+	for _, spec := range models.GetImageVariantSpecs(kind) {
+		source := decoded
+		if spec.Square {
+			source = utils.CropSquare(decoded)
+		}
+
+		variantBytes, err := utils.EncodeJPEG(utils.ResizeImage(source, spec.Width, spec.Height))
+		if err != nil {
+			i.deleteVariants(ctx, variants)
+			return nil, err
+		}
+
+		response, err := i.imageStorage.Upload(ctx, variantBytes, fmt.Sprintf("%s_%s.jpg", uploadImageName, spec.Name))
+		if err != nil {
+			i.deleteVariants(ctx, variants)
+			return nil, fmt.Errorf("upload %s variant: %w", spec.Name, err)
+		}
+
+		variants[spec.Name] = models.ImageVariant{
+			ID:  response.ID,
+			URL: response.URL,
+		}
+	}
+
+	return variants, nil
+}
+
+func (i *imageService) DeleteImage(ctx context.Context, imageID uuid.UUID) error {
+	if err := i.imageStorage.Delete(ctx, imageID); err != nil {
+		return fmt.Errorf("delete image %q: %w", imageID, err)
+	}
+
+	return nil
+}
+
+func (i *imageService) ScheduleImageDeletion(imageIDs []uuid.UUID) error {
+	if len(imageIDs) == 0 {
+		return nil
+	}
+
+	message, err := jsoniter.Marshal(models.ImageDeleteTask{ImageIDs: imageIDs})
+	if err != nil {
+		return fmt.Errorf("marshal delete image task: %w", err)
+	}
+
+	if err := i.queueService.Publish(string(DeleteImageQueue), message); err != nil {
+		return fmt.Errorf("publish delete image task: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteOrphanImages deletes the stored images no book, author or user points
+// to anymore. The storage only lists images uploaded by this service, so
+// images of other apps sharing the same account are left alone.
+func (i *imageService) DeleteOrphanImages(ctx context.Context, safetyWindow time.Duration) (int, error) {
+	storedImages, err := i.imageStorage.List(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list stored images: %w", err)
+	}
+
+	referenced, err := i.imageRepository.GetReferencedImageIDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get referenced image ids: %w", err)
+	}
+
+	cutoff := time.Now().UTC().Add(-safetyWindow)
+
+	var deleted int
+	for _, storedImage := range storedImages {
+		if _, ok := referenced[storedImage.ID]; ok {
+			continue
+		}
+
+		if storedImage.UploadedAt.After(cutoff) {
+			continue
+		}
+
+		if err := i.DeleteImage(ctx, storedImage.ID); err != nil {
+			slog.Error(err.Error())
+			continue
+		}
+
+		deleted++
+	}
+
+	return deleted, nil
+}
+
+func (i *imageService) GetImageURL(imageID uuid.UUID) string {
+	return i.imageStorage.URL(imageID)
+}
+
+func (i *imageService) deleteVariants(ctx context.Context, variants models.ImageVariants) {
+	for _, variant := range variants {
+		if err := i.imageStorage.Delete(ctx, variant.ID); err != nil {
+			slog.Error(err.Error())
+		}
+	}
+}
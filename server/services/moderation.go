@@ -0,0 +1,223 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/G-Villarinho/book-wise-api/cache"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/repositories"
+	"github.com/google/uuid"
+)
+
+type ModerationService interface {
+	ReportEvaluation(ctx context.Context, evaluationID uuid.UUID, payload models.ReportEvaluationPayload) error
+	GetPaginatedModerationQueue(ctx context.Context, pagination *models.Pagination) (*models.PaginatedResponse[*models.ModerationEvaluationResponse], error)
+	HideEvaluation(ctx context.Context, ID uuid.UUID) error
+	RestoreEvaluation(ctx context.Context, ID uuid.UUID) error
+	DeleteEvaluation(ctx context.Context, ID uuid.UUID) error
+	WarnUser(ctx context.Context, evaluationID uuid.UUID, payload models.WarnUserPayload) error
+}
+
+type moderationService struct {
+	di                         *internal.Di
+	cacheService               cache.CacheService
+	feedService                FeedService
+	notificationService        NotificationService
+	evaluationRepository       repositories.EvaluationRepository
+	evaluationReportRepository repositories.EvaluationReportRepository
+}
+
+func NewModerationService(di *internal.Di) (ModerationService, error) {
+	cacheService, err := internal.Invoke[cache.CacheService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	feedService, err := internal.Invoke[FeedService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	notificationService, err := internal.Invoke[NotificationService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	evaluationRepository, err := internal.Invoke[repositories.EvaluationRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	evaluationReportRepository, err := internal.Invoke[repositories.EvaluationReportRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &moderationService{
+		di:                         di,
+		cacheService:               cacheService,
+		feedService:                feedService,
+		notificationService:        notificationService,
+		evaluationRepository:       evaluationRepository,
+		evaluationReportRepository: evaluationReportRepository,
+	}, nil
+}
+
+func (m *moderationService) ReportEvaluation(ctx context.Context, evaluationID uuid.UUID, payload models.ReportEvaluationPayload) error {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return models.ErrUserNotFoundInContext
+	}
+
+	evaluation, err := m.evaluationRepository.GetEvaluationByID(ctx, evaluationID)
+	if err != nil {
+		return fmt.Errorf("get evaluation by id %q: %w", evaluationID, err)
+	}
+
+	if evaluation == nil || evaluation.Status != models.VisibleEvaluation {
+		return models.ErrEvaluationNotFound
+	}
+
+	if evaluation.UserID == session.UserID {
+		return models.ErrCannotReportOwnEvaluation
+	}
+
+	created, err := m.evaluationReportRepository.CreateEvaluationReport(ctx, payload.ToEvaluationReport(evaluationID, session.UserID))
+	if err != nil {
+		return fmt.Errorf("create report for evaluation %q: %w", evaluationID, err)
+	}
+
+	if !created {
+		return models.ErrEvaluationAlreadyReported
+	}
+
+	return nil
+}
+
+func (m *moderationService) GetPaginatedModerationQueue(ctx context.Context, pagination *models.Pagination) (*models.PaginatedResponse[*models.ModerationEvaluationResponse], error) {
+	paginatedEvaluations, err := m.evaluationReportRepository.GetPaginatedPendingModeration(ctx, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("get paginated pending moderation: %w", err)
+	}
+
+	paginatedEvaluationsResponse := models.MapPaginatedResult(paginatedEvaluations, func(evaluation models.Evaluation) *models.ModerationEvaluationResponse {
+		return evaluation.ToModerationEvaluationResponse()
+	})
+
+	return paginatedEvaluationsResponse, nil
+}
+
+func (m *moderationService) HideEvaluation(ctx context.Context, ID uuid.UUID) error {
+	evaluation, err := m.moderateEvaluation(ctx, ID, models.HideModerationAction, func(evaluation *models.Evaluation) error {
+		return m.evaluationRepository.UpdateEvaluationStatus(ctx, evaluation, models.HiddenEvaluation)
+	})
+	if err != nil {
+		return err
+	}
+
+	m.notifyAuthor(evaluation, models.EvaluationHiddenNotification, nil)
+	return nil
+}
+
+func (m *moderationService) RestoreEvaluation(ctx context.Context, ID uuid.UUID) error {
+	var approved bool
+	evaluation, err := m.moderateEvaluation(ctx, ID, models.RestoreModerationAction, func(evaluation *models.Evaluation) error {
+		approved = evaluation.Status == models.PendingEvaluation
+		return m.evaluationRepository.UpdateEvaluationStatus(ctx, evaluation, models.VisibleEvaluation)
+	})
+	if err != nil {
+		return err
+	}
+
+	if approved {
+		if err := m.feedService.RecordActivity(ctx, models.NewEvaluationCreatedActivity(evaluation)); err != nil {
+			slog.Error(err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (m *moderationService) DeleteEvaluation(ctx context.Context, ID uuid.UUID) error {
+	_, err := m.moderateEvaluation(ctx, ID, models.DeleteModerationAction, func(evaluation *models.Evaluation) error {
+		return m.evaluationRepository.DeleteEvaluation(ctx, evaluation)
+	})
+
+	return err
+}
+
+func (m *moderationService) WarnUser(ctx context.Context, evaluationID uuid.UUID, payload models.WarnUserPayload) error {
+	evaluation, err := m.moderateEvaluation(ctx, evaluationID, models.WarnModerationAction, nil)
+	if err != nil {
+		return err
+	}
+
+	m.notifyAuthor(evaluation, models.ModerationWarningNotification, map[string]string{
+		"message": payload.Message,
+	})
+
+	return nil
+}
+
+func (m *moderationService) moderateEvaluation(ctx context.Context, ID uuid.UUID, action models.ModerationAction, apply func(evaluation *models.Evaluation) error) (*models.Evaluation, error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	evaluation, err := m.evaluationRepository.GetEvaluationByID(ctx, ID)
+	if err != nil {
+		return nil, fmt.Errorf("get evaluation by id %q: %w", ID, err)
+	}
+
+	if evaluation == nil {
+		return nil, models.ErrEvaluationNotFound
+	}
+
+	if apply != nil {
+		if err := apply(evaluation); err != nil {
+			return nil, fmt.Errorf("%s evaluation %q: %w", action, ID, err)
+		}
+
+		m.invalidateRecommendations(ctx, evaluation)
+	}
+
+	if err := m.evaluationReportRepository.ResolveEvaluationReports(ctx, ID, session.UserID, action); err != nil {
+		return nil, fmt.Errorf("resolve reports of evaluation %q: %w", ID, err)
+	}
+
+	return evaluation, nil
+}
+
+// invalidateRecommendations drops the cached recommendations the evaluation
+// took part in, so hiding, restoring or deleting it is reflected right away.
+func (m *moderationService) invalidateRecommendations(ctx context.Context, evaluation *models.Evaluation) {
+	if err := InvalidateSimilarBooks(ctx, m.cacheService, []uuid.UUID{evaluation.BookID}); err != nil {
+		slog.Error(err.Error())
+	}
+
+	if err := m.cacheService.Delete(ctx, getRecommendationsKey(evaluation.UserID)); err != nil {
+		slog.Error(fmt.Sprintf("delete recommendations cache for user %q: %s", evaluation.UserID, err))
+	}
+}
+
+func (m *moderationService) notifyAuthor(evaluation *models.Evaluation, notificationType models.NotificationType, data map[string]string) {
+	if data == nil {
+		data = make(map[string]string)
+	}
+	data["evaluationId"] = evaluation.ID.String()
+
+	task := models.NotificationTask{
+		UserIDs: []uuid.UUID{evaluation.UserID},
+		Type:    notificationType,
+		BookID:  &evaluation.BookID,
+		Data:    data,
+	}
+
+	if err := m.notificationService.Notify(task); err != nil {
+		slog.Error(err.Error())
+	}
+}
@@ -0,0 +1,345 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/G-Villarinho/book-wise-api/cache"
+	"github.com/G-Villarinho/book-wise-api/config"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/repositories"
+	"github.com/G-Villarinho/book-wise-api/services/email"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+)
+
+type NotificationService interface {
+	Notify(task models.NotificationTask) error
+	CreateNotifications(ctx context.Context, task models.NotificationTask) (int64, error)
+	ScheduleBookFollowersNotification(bookID uuid.UUID) error
+	NotifyBookFollowers(ctx context.Context, bookID uuid.UUID) (int64, error)
+	GetPaginatedNotifications(ctx context.Context, pagination *models.NotificationPagination) (*models.PaginatedResponse[*models.NotificationResponse], error)
+	GetUnreadNotificationsCount(ctx context.Context) (*models.UnreadNotificationsResponse, error)
+	MarkNotificationAsRead(ctx context.Context, ID uuid.UUID) error
+	MarkAllNotificationsAsRead(ctx context.Context) error
+	SendEmailDigests(ctx context.Context) (int, error)
+}
+
+const (
+	defaultNotificationDigestInterval = time.Hour
+)
+
+type notificationService struct {
+	di                     *internal.Di
+	emailFactory           email.EmailFactory
+	cacheService           cache.CacheService
+	queueService           QueueService
+	eventService           EventService
+	bookRepository         repositories.BookRepository
+	followRepository       repositories.FollowRepository
+	notificationRepository repositories.NotificationRepository
+}
+
+func NewNotificationService(di *internal.Di) (NotificationService, error) {
+	cacheService, err := internal.Invoke[cache.CacheService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	queueService, err := internal.Invoke[QueueService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	eventService, err := internal.Invoke[EventService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	bookRepository, err := internal.Invoke[repositories.BookRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	followRepository, err := internal.Invoke[repositories.FollowRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	notificationRepository, err := internal.Invoke[repositories.NotificationRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &notificationService{
+		di:                     di,
+		emailFactory:           *email.NewEmailTaskFactory(),
+		cacheService:           cacheService,
+		queueService:           queueService,
+		eventService:           eventService,
+		bookRepository:         bookRepository,
+		followRepository:       followRepository,
+		notificationRepository: notificationRepository,
+	}, nil
+}
+
+func (n *notificationService) Notify(task models.NotificationTask) error {
+	if len(task.UserIDs) == 0 {
+		return nil
+	}
+
+	message, err := jsoniter.Marshal(task)
+	if err != nil {
+		return fmt.Errorf("marshal notification task: %w", err)
+	}
+
+	if err := n.queueService.Publish(CreateNotifications, message); err != nil {
+		return fmt.Errorf("publish notification task: %w", err)
+	}
+
+	return nil
+}
+
+func (n *notificationService) CreateNotifications(ctx context.Context, task models.NotificationTask) (int64, error) {
+	created, err := n.notificationRepository.CreateNotifications(ctx, task.ToNotifications(time.Now().UTC()))
+	if err != nil {
+		return 0, fmt.Errorf("create %s notifications: %w", task.Type, err)
+	}
+
+	for _, userID := range task.UserIDs {
+		if err := n.cacheService.Delete(ctx, getUnreadNotificationsKey(userID)); err != nil {
+			return created, fmt.Errorf("invalidate unread notifications of user %q: %w", userID, err)
+		}
+	}
+
+	event := models.NotificationCreatedEventData{
+		Type:   task.Type,
+		BookID: task.BookID,
+	}
+
+	for _, userID := range task.UserIDs {
+		if err := n.eventService.PublishToUser(ctx, userID, models.NotificationCreatedEvent, event); err != nil {
+			slog.Error(err.Error())
+		}
+	}
+
+	return created, nil
+}
+
+func (n *notificationService) ScheduleBookFollowersNotification(bookID uuid.UUID) error {
+	message, err := jsoniter.Marshal(models.BookFollowersTask{BookID: bookID})
+	if err != nil {
+		return fmt.Errorf("marshal book followers task: %w", err)
+	}
+
+	if err := n.queueService.Publish(NotifyBookFollowers, message); err != nil {
+		return fmt.Errorf("publish book followers task: %w", err)
+	}
+
+	return nil
+}
+
+func (n *notificationService) NotifyBookFollowers(ctx context.Context, bookID uuid.UUID) (int64, error) {
+	book, err := n.bookRepository.GetBookByID(ctx, bookID, false)
+	if err != nil {
+		return 0, fmt.Errorf("get book by id %q: %w", bookID, err)
+	}
+
+	if book == nil || !book.Published {
+		return 0, nil
+	}
+
+	followers, err := n.followRepository.GetBookFollowers(ctx, bookID)
+	if err != nil {
+		return 0, fmt.Errorf("get followers of book %q: %w", bookID, err)
+	}
+
+	// The dedup key is unique per user, so a book that is republished or
+	// reached through several follows is only notified once.
+	tasks := map[bool]*models.NotificationTask{}
+	for _, follower := range followers {
+		task, ok := tasks[follower.NotifyByEmail]
+		if !ok {
+			task = &models.NotificationTask{
+				Type:      models.NewBookNotification,
+				BookID:    &book.ID,
+				Data:      map[string]string{"bookTitle": book.Title},
+				DedupKey:  models.NewBookDedupKey(book.ID),
+				SendEmail: follower.NotifyByEmail,
+			}
+			tasks[follower.NotifyByEmail] = task
+		}
+
+		task.UserIDs = append(task.UserIDs, follower.UserID)
+	}
+
+	var notified int64
+	for _, task := range tasks {
+		created, err := n.CreateNotifications(ctx, *task)
+		if err != nil {
+			return notified, err
+		}
+
+		notified += created
+	}
+
+	return notified, nil
+}
+
+func (n *notificationService) GetPaginatedNotifications(ctx context.Context, pagination *models.NotificationPagination) (*models.PaginatedResponse[*models.NotificationResponse], error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	paginatedNotifications, err := n.notificationRepository.GetPaginatedNotificationsByUserID(ctx, session.UserID, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("get paginated notifications of user %q: %w", session.UserID, err)
+	}
+
+	paginatedNotificationsResponse := models.MapPaginatedResult(paginatedNotifications, func(notification models.Notification) *models.NotificationResponse {
+		return notification.ToNotificationResponse()
+	})
+
+	return paginatedNotificationsResponse, nil
+}
+
+func (n *notificationService) GetUnreadNotificationsCount(ctx context.Context) (*models.UnreadNotificationsResponse, error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	var count int64
+	key := getUnreadNotificationsKey(session.UserID)
+	if err := n.cacheService.Get(ctx, key, &count); err == nil {
+		return &models.UnreadNotificationsResponse{Count: count}, nil
+	} else if !errors.Is(err, cache.ErrCacheMiss) {
+		return nil, fmt.Errorf("get unread notifications count from cache: %w", err)
+	}
+
+	count, err := n.notificationRepository.CountUnreadNotifications(ctx, session.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("count unread notifications of user %q: %w", session.UserID, err)
+	}
+
+	ttl := time.Duration(config.Env.Cache.CacheExp) * time.Minute
+	if err := n.cacheService.Set(ctx, key, count, ttl); err != nil {
+		return nil, fmt.Errorf("set unread notifications count in cache: %w", err)
+	}
+
+	return &models.UnreadNotificationsResponse{Count: count}, nil
+}
+
+func (n *notificationService) MarkNotificationAsRead(ctx context.Context, ID uuid.UUID) error {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return models.ErrUserNotFoundInContext
+	}
+
+	notification, err := n.notificationRepository.GetNotificationByID(ctx, ID)
+	if err != nil {
+		return fmt.Errorf("get notification by id %q: %w", ID, err)
+	}
+
+	if notification == nil || notification.UserID != session.UserID {
+		return models.ErrNotificationNotFound
+	}
+
+	if notification.ReadAt.Valid {
+		return nil
+	}
+
+	if err := n.notificationRepository.MarkNotificationAsRead(ctx, ID, time.Now().UTC()); err != nil {
+		return fmt.Errorf("mark notification %q as read: %w", ID, err)
+	}
+
+	return n.cacheService.Delete(ctx, getUnreadNotificationsKey(session.UserID))
+}
+
+func (n *notificationService) MarkAllNotificationsAsRead(ctx context.Context) error {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return models.ErrUserNotFoundInContext
+	}
+
+	if err := n.notificationRepository.MarkAllNotificationsAsRead(ctx, session.UserID, time.Now().UTC()); err != nil {
+		return fmt.Errorf("mark all notifications of user %q as read: %w", session.UserID, err)
+	}
+
+	return n.cacheService.Delete(ctx, getUnreadNotificationsKey(session.UserID))
+}
+
+func (n *notificationService) SendEmailDigests(ctx context.Context) (int, error) {
+	notifications, err := n.notificationRepository.GetPendingEmailNotifications(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get pending email notifications: %w", err)
+	}
+
+	var userIDs []uuid.UUID
+	notificationsByUser := make(map[uuid.UUID][]models.Notification)
+	for _, notification := range notifications {
+		if _, ok := notificationsByUser[notification.UserID]; !ok {
+			userIDs = append(userIDs, notification.UserID)
+		}
+		notificationsByUser[notification.UserID] = append(notificationsByUser[notification.UserID], notification)
+	}
+
+	var sent int
+	for _, userID := range userIDs {
+		userNotifications := notificationsByUser[userID]
+		user := userNotifications[0].User
+
+		var books strings.Builder
+		IDs := make([]uuid.UUID, 0, len(userNotifications))
+		for _, notification := range userNotifications {
+			IDs = append(IDs, notification.ID)
+			if notification.Book != nil {
+				books.WriteString("<li>" + html.EscapeString(notification.Book.Title) + "</li>")
+			}
+		}
+
+		if books.Len() == 0 {
+			if err := n.notificationRepository.MarkNotificationsEmailed(ctx, IDs, time.Now().UTC()); err != nil {
+				return sent, fmt.Errorf("mark notifications emailed for user %q: %w", userID, err)
+			}
+			continue
+		}
+
+		task := n.emailFactory.CreateNewBooksDigestEmail(user.Email, html.EscapeString(user.FullName), books.String(), config.Env.MemberFrontURL)
+		message, err := jsoniter.Marshal(task)
+		if err != nil {
+			return sent, fmt.Errorf("marshal email task: %w", err)
+		}
+
+		if err := n.queueService.Publish(QueueSendEmail, message); err != nil {
+			return sent, fmt.Errorf("publish email task: %w", err)
+		}
+
+		if err := n.notificationRepository.MarkNotificationsEmailed(ctx, IDs, time.Now().UTC()); err != nil {
+			return sent, fmt.Errorf("mark notifications emailed for user %q: %w", userID, err)
+		}
+
+		sent++
+	}
+
+	return sent, nil
+}
+
+func getUnreadNotificationsKey(userID uuid.UUID) string {
+	return fmt.Sprintf("notifications:unread:%s", userID.String())
+}
+
+func GetNotificationDigestInterval() time.Duration {
+	if config.Env.NotificationDigest.Interval <= 0 {
+		return defaultNotificationDigestInterval
+	}
+
+	return time.Duration(config.Env.NotificationDigest.Interval) * time.Minute
+}
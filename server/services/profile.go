@@ -0,0 +1,197 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/G-Villarinho/book-wise-api/cache"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/repositories"
+)
+
+type ProfileService interface {
+	GetProfile(ctx context.Context, handle string) (*models.ProfileResponse, error)
+	ClaimHandle(ctx context.Context, payload models.ClaimHandlePayload) (*models.UserResponse, error)
+	UpdatePrivacy(ctx context.Context, payload models.UpdatePrivacyPayload) (*models.PrivacyResponse, error)
+}
+
+type profileService struct {
+	di                       *internal.Di
+	cacheService             cache.CacheService
+	bookListRepository       repositories.BookListRepository
+	evaluationRepository     repositories.EvaluationRepository
+	followRepository         repositories.FollowRepository
+	readingSessionRepository repositories.ReadingSessionRepository
+	userRepository           repositories.UserRepository
+}
+
+func NewProfileService(di *internal.Di) (ProfileService, error) {
+	cacheService, err := internal.Invoke[cache.CacheService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	bookListRepository, err := internal.Invoke[repositories.BookListRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	evaluationRepository, err := internal.Invoke[repositories.EvaluationRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	followRepository, err := internal.Invoke[repositories.FollowRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	readingSessionRepository, err := internal.Invoke[repositories.ReadingSessionRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	userRepository, err := internal.Invoke[repositories.UserRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &profileService{
+		di:                       di,
+		cacheService:             cacheService,
+		bookListRepository:       bookListRepository,
+		evaluationRepository:     evaluationRepository,
+		followRepository:         followRepository,
+		readingSessionRepository: readingSessionRepository,
+		userRepository:           userRepository,
+	}, nil
+}
+
+func (p *profileService) GetProfile(ctx context.Context, handle string) (*models.ProfileResponse, error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	user, err := p.userRepository.GetUserByHandle(ctx, models.NormalizeHandle(handle))
+	if err != nil {
+		return nil, fmt.Errorf("get user by handle %q: %w", handle, err)
+	}
+
+	if user == nil || user.Role != models.Member || user.Status != models.Active || !user.IsProfileVisibleTo(session.UserID) {
+		return nil, models.ErrProfileNotFound
+	}
+
+	response := user.ToProfileResponse(session.UserID)
+
+	if !response.ShelvesHidden {
+		booksRead, err := p.readingSessionRepository.CountFinishedBooks(ctx, user.ID)
+		if err != nil {
+			return nil, fmt.Errorf("count finished books of user %q: %w", user.ID, err)
+		}
+		response.BooksRead = &booksRead
+	}
+
+	if !response.ReviewsHidden {
+		evaluations, err := p.evaluationRepository.GetRecentUserEvaluations(ctx, user.ID, models.ProfileRecentReviewsLimit)
+		if err != nil {
+			return nil, fmt.Errorf("get recent evaluations of user %q: %w", user.ID, err)
+		}
+
+		response.RecentReviews = make([]models.ProfileReviewResponse, 0, len(evaluations))
+		for _, evaluation := range evaluations {
+			response.RecentReviews = append(response.RecentReviews, models.ProfileReviewResponse{
+				EvaluationBasicInfoResponse: *evaluation.ToEvaluationBasicInfoResponse(session.UserID),
+				Book:                        evaluation.Book.ToBookSummaryResponse(),
+			})
+		}
+	}
+
+	bookLists, err := p.bookListRepository.GetRecentPublicBookListsByUserID(ctx, user.ID, models.ProfilePublicListsLimit)
+	if err != nil {
+		return nil, fmt.Errorf("get public book lists of user %q: %w", user.ID, err)
+	}
+
+	for _, bookList := range bookLists {
+		response.PublicLists = append(response.PublicLists, bookList.ToBookListResponse(session.UserID))
+	}
+
+	if user.ID != session.UserID {
+		follow, err := p.followRepository.GetFollow(ctx, session.UserID, models.UserFollowTarget, user.ID)
+		if err != nil {
+			return nil, fmt.Errorf("get user %q follow of user %q: %w", session.UserID, user.ID, err)
+		}
+		response.FollowedByMe = follow != nil
+	}
+
+	return response, nil
+}
+
+func (p *profileService) ClaimHandle(ctx context.Context, payload models.ClaimHandlePayload) (*models.UserResponse, error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	handle := models.NormalizeHandle(payload.Handle)
+	if err := models.ValidateHandle(handle); err != nil {
+		return nil, err
+	}
+
+	owner, err := p.userRepository.GetUserByHandle(ctx, handle)
+	if err != nil {
+		return nil, fmt.Errorf("get user by handle %q: %w", handle, err)
+	}
+
+	if owner != nil && owner.ID != session.UserID {
+		return nil, models.ErrHandleAlreadyTaken
+	}
+
+	user, err := p.userRepository.GetUserByID(ctx, session.UserID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("get user by id %q: %w", session.UserID, err)
+	}
+
+	if user == nil {
+		return nil, models.ErrUserNotFound
+	}
+
+	if err := p.userRepository.UpdateUserHandle(ctx, user.ID, handle); err != nil {
+		return nil, fmt.Errorf("update handle of user %q: %w", user.ID, err)
+	}
+
+	if err := p.cacheService.Delete(ctx, GetUserKey(user.ID)); err != nil {
+		return nil, fmt.Errorf("delete user %q from cache: %w", user.ID, err)
+	}
+
+	user.Handle.String, user.Handle.Valid = handle, true
+	return user.ToUserResponse(), nil
+}
+
+func (p *profileService) UpdatePrivacy(ctx context.Context, payload models.UpdatePrivacyPayload) (*models.PrivacyResponse, error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	user, err := p.userRepository.GetUserByID(ctx, session.UserID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("get user by id %q: %w", session.UserID, err)
+	}
+
+	if user == nil {
+		return nil, models.ErrUserNotFound
+	}
+
+	user.ApplyPrivacy(payload)
+	if err := p.userRepository.UpdateUserPrivacy(ctx, user); err != nil {
+		return nil, fmt.Errorf("update privacy of user %q: %w", user.ID, err)
+	}
+
+	if err := p.cacheService.Delete(ctx, GetUserKey(user.ID)); err != nil {
+		return nil, fmt.Errorf("delete user %q from cache: %w", user.ID, err)
+	}
+
+	return user.ToPrivacyResponse(), nil
+}
@@ -8,9 +8,14 @@ import (
 )
 
 const (
-	QueueSendEmail    = "send_email_queue"
-	UploadAuthorImage = "upload_author_image_queue"
-	UploadUserImage   = "upload_user_image"
+	QueueSendEmail         = "send_email_queue"
+	UploadAuthorImage      = "upload_author_image_queue"
+	UploadUserImage        = "upload_user_image"
+	UploadBookCover        = "upload_book_cover_queue"
+	ComputeRecommendations = "compute_recommendations_queue"
+	NotifyBookFollowers    = "notify_book_followers_queue"
+	CreateNotifications    = "create_notifications_queue"
+	FanOutActivities       = "fan_out_activities_queue"
 )
 
 //go:generate mockery --name=QueueService --output=../mocks --outpkg=mocks
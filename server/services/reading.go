@@ -0,0 +1,314 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/repositories"
+	"github.com/google/uuid"
+)
+
+const (
+	statsWeeks              = 12
+	statsMonths             = 12
+	statsFavoriteCategories = 5
+)
+
+type ReadingService interface {
+	CreateReadingSession(ctx context.Context, bookID uuid.UUID, payload models.CreateReadingSessionPayload) (*models.ReadingProgressResponse, error)
+	GetPaginatedReadingSessions(ctx context.Context, bookID uuid.UUID, pagination *models.Pagination) (*models.PaginatedResponse[*models.ReadingSessionResponse], error)
+	DeleteReadingSession(ctx context.Context, bookID, ID uuid.UUID) error
+	GetReadingProgress(ctx context.Context, bookID uuid.UUID) (*models.ReadingProgressResponse, error)
+	GetReadingStats(ctx context.Context) (*models.ReadingStatsResponse, error)
+}
+
+type readingService struct {
+	di                       *internal.Di
+	feedService              FeedService
+	bookRepository           repositories.BookRepository
+	bookShelfRepository      repositories.BookShelfRepository
+	evaluationRepository     repositories.EvaluationRepository
+	readingSessionRepository repositories.ReadingSessionRepository
+}
+
+func NewReadingService(di *internal.Di) (ReadingService, error) {
+	feedService, err := internal.Invoke[FeedService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	bookRepository, err := internal.Invoke[repositories.BookRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	bookShelfRepository, err := internal.Invoke[repositories.BookShelfRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	evaluationRepository, err := internal.Invoke[repositories.EvaluationRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	readingSessionRepository, err := internal.Invoke[repositories.ReadingSessionRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &readingService{
+		di:                       di,
+		feedService:              feedService,
+		bookRepository:           bookRepository,
+		bookShelfRepository:      bookShelfRepository,
+		evaluationRepository:     evaluationRepository,
+		readingSessionRepository: readingSessionRepository,
+	}, nil
+}
+
+func (r *readingService) CreateReadingSession(ctx context.Context, bookID uuid.UUID, payload models.CreateReadingSessionPayload) (*models.ReadingProgressResponse, error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	book, err := r.getPublishedBook(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	readingSession, err := payload.ToReadingSession(session.UserID, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.readingSessionRepository.CreateReadingSession(ctx, readingSession); err != nil {
+		return nil, fmt.Errorf("create reading session: %w", err)
+	}
+
+	progress, err := r.readingSessionRepository.GetReadingProgress(ctx, session.UserID, bookID)
+	if err != nil {
+		return nil, fmt.Errorf("get user %q reading progress for book %q: %w", session.UserID, bookID, err)
+	}
+
+	bookShelf, err := r.updateBookShelf(ctx, session.UserID, book, progress, readingSession.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	return progress.ToReadingProgressResponse(book.TotalPages, bookShelf), nil
+}
+
+func (r *readingService) GetPaginatedReadingSessions(ctx context.Context, bookID uuid.UUID, pagination *models.Pagination) (*models.PaginatedResponse[*models.ReadingSessionResponse], error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	if _, err := r.getPublishedBook(ctx, bookID); err != nil {
+		return nil, err
+	}
+
+	paginatedReadingSessions, err := r.readingSessionRepository.GetPaginatedReadingSessions(ctx, session.UserID, bookID, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("get paginated reading sessions for book %q: %w", bookID, err)
+	}
+
+	paginatedReadingSessionsResponse := models.MapPaginatedResult(paginatedReadingSessions, func(readingSession models.ReadingSession) *models.ReadingSessionResponse {
+		return readingSession.ToReadingSessionResponse()
+	})
+
+	return paginatedReadingSessionsResponse, nil
+}
+
+func (r *readingService) DeleteReadingSession(ctx context.Context, bookID, ID uuid.UUID) error {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return models.ErrUserNotFoundInContext
+	}
+
+	readingSession, err := r.readingSessionRepository.GetReadingSessionByID(ctx, ID)
+	if err != nil {
+		return fmt.Errorf("get reading session by id %q: %w", ID, err)
+	}
+
+	if readingSession == nil || readingSession.BookID != bookID || readingSession.UserID != session.UserID {
+		return models.ErrReadingSessionNotFound
+	}
+
+	if err := r.readingSessionRepository.DeleteReadingSessionByID(ctx, ID); err != nil {
+		return fmt.Errorf("delete reading session %q: %w", ID, err)
+	}
+
+	return nil
+}
+
+func (r *readingService) GetReadingProgress(ctx context.Context, bookID uuid.UUID) (*models.ReadingProgressResponse, error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	book, err := r.getPublishedBook(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := r.readingSessionRepository.GetReadingProgress(ctx, session.UserID, bookID)
+	if err != nil {
+		return nil, fmt.Errorf("get user %q reading progress for book %q: %w", session.UserID, bookID, err)
+	}
+
+	bookShelf, err := r.bookShelfRepository.GetBookShelf(ctx, session.UserID, bookID)
+	if err != nil {
+		return nil, fmt.Errorf("get user %q shelf for book %q: %w", session.UserID, bookID, err)
+	}
+
+	return progress.ToReadingProgressResponse(book.TotalPages, bookShelf), nil
+}
+
+func (r *readingService) GetReadingStats(ctx context.Context) (*models.ReadingStatsResponse, error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	daysSinceMonday := (int(today.Weekday()) + 6) % 7
+	firstWeek := today.AddDate(0, 0, -daysSinceMonday-7*(statsWeeks-1))
+	firstMonth := time.Date(now.Year(), now.Month()-statsMonths+1, 1, 0, 0, 0, 0, time.UTC)
+
+	totals, err := r.readingSessionRepository.GetReadingTotals(ctx, session.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("get user %q reading totals: %w", session.UserID, err)
+	}
+
+	finishedBooks, err := r.readingSessionRepository.CountFinishedBooks(ctx, session.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("count user %q finished books: %w", session.UserID, err)
+	}
+
+	ratingSummary, err := r.evaluationRepository.GetUserRatingSummary(ctx, session.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("get user %q rating summary: %w", session.UserID, err)
+	}
+
+	pagesPerWeek, err := r.readingSessionRepository.GetPagesPerWeek(ctx, session.UserID, firstWeek)
+	if err != nil {
+		return nil, fmt.Errorf("get user %q pages per week: %w", session.UserID, err)
+	}
+
+	booksPerMonth, err := r.readingSessionRepository.GetBooksFinishedPerMonth(ctx, session.UserID, firstMonth)
+	if err != nil {
+		return nil, fmt.Errorf("get user %q books finished per month: %w", session.UserID, err)
+	}
+
+	favoriteCategories, err := r.readingSessionRepository.GetFavoriteCategories(ctx, session.UserID, statsFavoriteCategories)
+	if err != nil {
+		return nil, fmt.Errorf("get user %q favorite categories: %w", session.UserID, err)
+	}
+
+	weeks := countsByPeriod(pagesPerWeek)
+	pagesPerWeekResponse := make([]models.WeeklyPagesResponse, 0, statsWeeks)
+	for i := 0; i < statsWeeks; i++ {
+		weekStart := firstWeek.AddDate(0, 0, 7*i).Format(models.ReadingSessionDateLayout)
+		pagesPerWeekResponse = append(pagesPerWeekResponse, models.WeeklyPagesResponse{
+			WeekStart: weekStart,
+			Pages:     weeks[weekStart],
+		})
+	}
+
+	months := countsByPeriod(booksPerMonth)
+	booksPerMonthResponse := make([]models.MonthlyFinishedBooksResponse, 0, statsMonths)
+	for i := 0; i < statsMonths; i++ {
+		month := firstMonth.AddDate(0, i, 0).Format("2006-01")
+		booksPerMonthResponse = append(booksPerMonthResponse, models.MonthlyFinishedBooksResponse{
+			Month: month,
+			Books: months[month],
+		})
+	}
+
+	locale := models.GetLocaleFromContext(ctx)
+	favoriteCategoriesResponse := make([]models.FavoriteCategoryResponse, 0, len(favoriteCategories))
+	for _, category := range favoriteCategories {
+		favoriteCategoriesResponse = append(favoriteCategoriesResponse, category.ToFavoriteCategoryResponse(locale))
+	}
+
+	return &models.ReadingStatsResponse{
+		TotalPagesRead:     totals.PagesRead,
+		TotalMinutes:       totals.Minutes,
+		TotalSessions:      totals.Sessions,
+		TotalBooksFinished: finishedBooks,
+		AverageRating:      ratingSummary.Average,
+		TotalRatings:       ratingSummary.Total,
+		PagesPerWeek:       pagesPerWeekResponse,
+		BooksPerMonth:      booksPerMonthResponse,
+		FavoriteCategories: favoriteCategoriesResponse,
+	}, nil
+}
+
+func (r *readingService) getPublishedBook(ctx context.Context, bookID uuid.UUID) (*models.Book, error) {
+	book, err := r.bookRepository.GetBookByID(ctx, bookID, false)
+	if err != nil {
+		return nil, fmt.Errorf("get book by id %q: %w", bookID, err)
+	}
+
+	if book == nil || !book.Published {
+		return nil, models.ErrBookNotFound
+	}
+
+	return book, nil
+}
+
+// updateBookShelf moves the book along the user's shelf as sessions are logged.
+// The shelf dates follow the session date, so a backdated session that
+// finishes the book counts towards the period it was actually read in.
+func (r *readingService) updateBookShelf(ctx context.Context, userID uuid.UUID, book *models.Book, progress *models.ReadingProgress, readAt time.Time) (*models.BookShelf, error) {
+	bookShelf, err := r.bookShelfRepository.GetBookShelf(ctx, userID, book.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get user %q shelf for book %q: %w", userID, book.ID, err)
+	}
+
+	status := models.ReadingShelf
+	if progress.IsFinished(book.TotalPages) {
+		status = models.ReadShelf
+	}
+
+	now := time.Now().UTC()
+	if bookShelf == nil {
+		bookShelf = models.NewBookShelf(userID, book.ID, now)
+	} else if bookShelf.Status == status || bookShelf.Status == models.ReadShelf {
+		return bookShelf, nil
+	} else {
+		bookShelf.UpdatedAt = sql.NullTime{Time: now, Valid: true}
+	}
+
+	bookShelf.ApplyStatus(status, readAt)
+	if err := r.bookShelfRepository.SaveBookShelf(ctx, bookShelf); err != nil {
+		return nil, fmt.Errorf("save user %q shelf for book %q: %w", userID, book.ID, err)
+	}
+
+	if status == models.ReadShelf {
+		if err := r.feedService.RecordActivity(ctx, models.NewBookFinishedActivity(userID, book.ID)); err != nil {
+			slog.Error(err.Error())
+		}
+	}
+
+	return bookShelf, nil
+}
+
+func countsByPeriod(periods []models.PeriodCount) map[string]int64 {
+	counts := make(map[string]int64, len(periods))
+	for _, period := range periods {
+		counts[period.Period] = period.Total
+	}
+
+	return counts
+}
@@ -0,0 +1,191 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/G-Villarinho/book-wise-api/config"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/repositories"
+	"github.com/G-Villarinho/book-wise-api/services/email"
+	jsoniter "github.com/json-iterator/go"
+)
+
+const defaultReadingChallengeSummaryInterval = time.Hour
+
+type ReadingChallengeService interface {
+	SetReadingChallenge(ctx context.Context, year int, payload models.SetReadingChallengePayload) (*models.ReadingChallengeResponse, error)
+	GetReadingChallenge(ctx context.Context, year int) (*models.ReadingChallengeResponse, error)
+	SendYearEndSummaries(ctx context.Context) (int, error)
+}
+
+type readingChallengeService struct {
+	di                         *internal.Di
+	queueService               QueueService
+	emailFactory               email.EmailFactory
+	bookShelfRepository        repositories.BookShelfRepository
+	readingChallengeRepository repositories.ReadingChallengeRepository
+}
+
+func NewReadingChallengeService(di *internal.Di) (ReadingChallengeService, error) {
+	queueService, err := internal.Invoke[QueueService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	bookShelfRepository, err := internal.Invoke[repositories.BookShelfRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	readingChallengeRepository, err := internal.Invoke[repositories.ReadingChallengeRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &readingChallengeService{
+		di:                         di,
+		queueService:               queueService,
+		emailFactory:               *email.NewEmailTaskFactory(),
+		bookShelfRepository:        bookShelfRepository,
+		readingChallengeRepository: readingChallengeRepository,
+	}, nil
+}
+
+func (r *readingChallengeService) SetReadingChallenge(ctx context.Context, year int, payload models.SetReadingChallengePayload) (*models.ReadingChallengeResponse, error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	now := time.Now().UTC()
+	if year != now.Year() && year != now.Year()+1 {
+		return nil, models.ErrInvalidChallengeYear
+	}
+
+	challenge, err := r.readingChallengeRepository.GetReadingChallenge(ctx, session.UserID, year)
+	if err != nil {
+		return nil, fmt.Errorf("get user %q reading challenge for %d: %w", session.UserID, year, err)
+	}
+
+	if challenge == nil {
+		challenge = models.NewReadingChallenge(session.UserID, year, payload.Goal)
+	} else {
+		challenge.Goal = payload.Goal
+		challenge.UpdatedAt = sql.NullTime{Time: now, Valid: true}
+	}
+
+	if err := r.readingChallengeRepository.SaveReadingChallenge(ctx, challenge); err != nil {
+		return nil, fmt.Errorf("save user %q reading challenge for %d: %w", session.UserID, year, err)
+	}
+
+	return r.toReadingChallengeResponse(ctx, challenge, now)
+}
+
+func (r *readingChallengeService) GetReadingChallenge(ctx context.Context, year int) (*models.ReadingChallengeResponse, error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	challenge, err := r.readingChallengeRepository.GetReadingChallenge(ctx, session.UserID, year)
+	if err != nil {
+		return nil, fmt.Errorf("get user %q reading challenge for %d: %w", session.UserID, year, err)
+	}
+
+	if challenge == nil {
+		return nil, models.ErrReadingChallengeNotFound
+	}
+
+	return r.toReadingChallengeResponse(ctx, challenge, time.Now().UTC())
+}
+
+func (r *readingChallengeService) SendYearEndSummaries(ctx context.Context) (int, error) {
+	challenges, err := r.readingChallengeRepository.GetPendingChallengeSummaries(ctx, time.Now().UTC().Year())
+	if err != nil {
+		return 0, fmt.Errorf("get pending reading challenge summaries: %w", err)
+	}
+
+	var sent int
+	for _, challenge := range challenges {
+		if challenge.User.Email == "" {
+			if err := r.readingChallengeRepository.MarkChallengeSummarySent(ctx, challenge.ID, time.Now().UTC()); err != nil {
+				return sent, fmt.Errorf("mark reading challenge %q summary sent: %w", challenge.ID, err)
+			}
+			continue
+		}
+
+		from, to := challengeYearRange(challenge.Year)
+		books, err := r.bookShelfRepository.GetBooksFinishedBetween(ctx, challenge.UserID, from, to)
+		if err != nil {
+			return sent, fmt.Errorf("get books finished by user %q in %d: %w", challenge.UserID, challenge.Year, err)
+		}
+
+		var titles strings.Builder
+		for _, book := range books {
+			titles.WriteString("<li>" + html.EscapeString(book.Title) + "</li>")
+		}
+
+		result := fmt.Sprintf("You were %d books away from your goal. A new year means a new challenge!", int(challenge.Goal)-len(books))
+		if len(books) >= int(challenge.Goal) {
+			result = "Congratulations, you completed your reading challenge!"
+		}
+
+		task := r.emailFactory.CreateReadingChallengeSummaryEmail(
+			challenge.User.Email,
+			html.EscapeString(challenge.User.FullName),
+			strconv.Itoa(challenge.Year),
+			strconv.Itoa(int(challenge.Goal)),
+			strconv.Itoa(len(books)),
+			result,
+			titles.String(),
+			config.Env.MemberFrontURL,
+		)
+
+		message, err := jsoniter.Marshal(task)
+		if err != nil {
+			return sent, fmt.Errorf("marshal email task: %w", err)
+		}
+
+		if err := r.queueService.Publish(QueueSendEmail, message); err != nil {
+			return sent, fmt.Errorf("publish email task: %w", err)
+		}
+
+		if err := r.readingChallengeRepository.MarkChallengeSummarySent(ctx, challenge.ID, time.Now().UTC()); err != nil {
+			return sent, fmt.Errorf("mark reading challenge %q summary sent: %w", challenge.ID, err)
+		}
+
+		sent++
+	}
+
+	return sent, nil
+}
+
+func (r *readingChallengeService) toReadingChallengeResponse(ctx context.Context, challenge *models.ReadingChallenge, now time.Time) (*models.ReadingChallengeResponse, error) {
+	from, to := challengeYearRange(challenge.Year)
+	booksFinished, err := r.bookShelfRepository.CountBooksFinishedBetween(ctx, challenge.UserID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("count books finished by user %q in %d: %w", challenge.UserID, challenge.Year, err)
+	}
+
+	return challenge.ToReadingChallengeResponse(booksFinished, now), nil
+}
+
+func challengeYearRange(year int) (time.Time, time.Time) {
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	return from, from.AddDate(1, 0, 0)
+}
+
+func GetReadingChallengeSummaryInterval() time.Duration {
+	if config.Env.ReadingChallenge.SummaryInterval <= 0 {
+		return defaultReadingChallengeSummaryInterval
+	}
+
+	return time.Duration(config.Env.ReadingChallenge.SummaryInterval) * time.Minute
+}
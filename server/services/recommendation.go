@@ -0,0 +1,410 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/G-Villarinho/book-wise-api/cache"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/G-Villarinho/book-wise-api/repositories"
+	"github.com/google/uuid"
+	jsoniter "github.com/json-iterator/go"
+)
+
+const (
+	recommendationsTTL           = 24 * time.Hour
+	recommendationsComputingTTL  = time.Minute
+	similarBooksTTL              = 24 * time.Hour
+	recommendationCandidateLimit = 500
+	recommendationLikedRate      = 4
+	recommendationNeutralRate    = 3
+	categoryAffinityWeight       = 1.0
+	authorAffinityWeight         = 1.5
+	coRatingAffinityWeight       = 2.0
+)
+
+type RecommendationService interface {
+	GetRecommendedBooks(ctx context.Context, limit int) ([]*models.PublishedBookResponse, error)
+	ComputeRecommendations(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+	ScheduleRecommendations(userID uuid.UUID) error
+	GetSimilarBooks(ctx context.Context, bookID uuid.UUID, limit int) ([]*models.PublishedBookResponse, error)
+}
+
+type recommendationService struct {
+	di                       *internal.Di
+	cacheService             cache.CacheService
+	queueService             QueueService
+	recommendationRepository repositories.RecommendationRepository
+}
+
+func NewRecommendationService(di *internal.Di) (RecommendationService, error) {
+	cacheService, err := internal.Invoke[cache.CacheService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	queueService, err := internal.Invoke[QueueService](di)
+	if err != nil {
+		return nil, err
+	}
+
+	recommendationRepository, err := internal.Invoke[repositories.RecommendationRepository](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recommendationService{
+		di:                       di,
+		cacheService:             cacheService,
+		queueService:             queueService,
+		recommendationRepository: recommendationRepository,
+	}, nil
+}
+
+func (r *recommendationService) GetRecommendedBooks(ctx context.Context, limit int) ([]*models.PublishedBookResponse, error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	var recommendedIDs []uuid.UUID
+	if err := r.cacheService.Get(ctx, getRecommendationsKey(session.UserID), &recommendedIDs); err != nil {
+		if !errors.Is(err, cache.ErrCacheMiss) {
+			return nil, fmt.Errorf("get recommendations from cache: %w", err)
+		}
+
+		computing, err := r.cacheService.SetIfNotExists(ctx, getRecommendationsComputingKey(session.UserID), true, recommendationsComputingTTL)
+		if err != nil {
+			return nil, fmt.Errorf("set recommendations computing marker: %w", err)
+		}
+
+		if computing {
+			if err := r.ScheduleRecommendations(session.UserID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var books []models.Book
+	if len(recommendedIDs) > 0 {
+		recommendedBooks, err := r.recommendationRepository.GetPublishedBooksByID(ctx, recommendedIDs)
+		if err != nil {
+			return nil, fmt.Errorf("get published books by id: %w", err)
+		}
+
+		booksByID := make(map[uuid.UUID]models.Book, len(recommendedBooks))
+		for _, book := range recommendedBooks {
+			booksByID[book.ID] = book
+		}
+
+		for _, ID := range recommendedIDs {
+			book, exists := booksByID[ID]
+			if !exists || userHasReadBook(session.UserID, book.Evaluations) {
+				continue
+			}
+
+			books = append(books, book)
+			if len(books) == limit {
+				break
+			}
+		}
+	}
+
+	if len(books) < limit {
+		var excludedIDs []uuid.UUID
+		for _, book := range books {
+			excludedIDs = append(excludedIDs, book.ID)
+		}
+
+		popularBooks, err := r.recommendationRepository.GetPopularBooks(ctx, session.UserID, excludedIDs, limit-len(books))
+		if err != nil {
+			return nil, fmt.Errorf("get popular books: %w", err)
+		}
+
+		books = append(books, popularBooks...)
+	}
+
+	var response []*models.PublishedBookResponse
+	for _, book := range books {
+		response = append(response, book.ToPublishedBookResponse(calculateAverageRating(book.Evaluations), false))
+	}
+
+	return response, nil
+}
+
+func (r *recommendationService) ComputeRecommendations(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	evaluations, err := r.recommendationRepository.GetUserEvaluations(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get user %q evaluations: %w", userID, err)
+	}
+
+	var recommendedIDs []uuid.UUID
+	if len(evaluations) > 0 {
+		recommendedIDs, err = r.scoreBooks(ctx, userID, evaluations)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := r.cacheService.Set(ctx, getRecommendationsKey(userID), recommendedIDs, recommendationsTTL); err != nil {
+		return nil, fmt.Errorf("set recommendations to cache: %w", err)
+	}
+
+	return recommendedIDs, nil
+}
+
+func (r *recommendationService) ScheduleRecommendations(userID uuid.UUID) error {
+	message, err := jsoniter.Marshal(models.RecommendationTask{UserID: userID})
+	if err != nil {
+		return fmt.Errorf("marshal recommendation task: %w", err)
+	}
+
+	if err := r.queueService.Publish(ComputeRecommendations, message); err != nil {
+		return fmt.Errorf("publish recommendation task: %w", err)
+	}
+
+	return nil
+}
+
+func (r *recommendationService) scoreBooks(ctx context.Context, userID uuid.UUID, evaluations []models.Evaluation) ([]uuid.UUID, error) {
+	rates := make(map[uuid.UUID]uint8, len(evaluations))
+	var ratedIDs, likedIDs []uuid.UUID
+	for _, evaluation := range evaluations {
+		rates[evaluation.BookID] = evaluation.Rate
+		ratedIDs = append(ratedIDs, evaluation.BookID)
+
+		if evaluation.Rate >= recommendationLikedRate {
+			likedIDs = append(likedIDs, evaluation.BookID)
+		}
+	}
+
+	ratedBooks, err := r.recommendationRepository.GetBooksWithAssociations(ctx, ratedIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get rated books: %w", err)
+	}
+
+	categoryWeights := make(map[uuid.UUID]float64)
+	authorWeights := make(map[uuid.UUID]float64)
+	for _, book := range ratedBooks {
+		weight := float64(rates[book.ID]) - recommendationNeutralRate
+
+		for _, category := range book.Categories {
+			categoryWeights[category.ID] += weight
+		}
+
+		for _, author := range book.Authors {
+			authorWeights[author.ID] += weight
+		}
+	}
+
+	candidates, err := r.recommendationRepository.GetCandidateBooks(ctx, userID, positiveKeys(categoryWeights), positiveKeys(authorWeights), recommendationCandidateLimit)
+	if err != nil {
+		return nil, fmt.Errorf("get candidate books: %w", err)
+	}
+
+	scores := make(map[uuid.UUID]float64)
+	for _, book := range candidates {
+		var score float64
+		for _, category := range book.Categories {
+			score += categoryAffinityWeight * categoryWeights[category.ID]
+		}
+
+		for _, author := range book.Authors {
+			score += authorAffinityWeight * authorWeights[author.ID]
+		}
+
+		scores[book.ID] = score
+	}
+
+	coRated, err := r.recommendationRepository.GetCoRatedBooks(ctx, userID, likedIDs, recommendationLikedRate, recommendationCandidateLimit)
+	if err != nil {
+		return nil, fmt.Errorf("get co-rated books: %w", err)
+	}
+
+	for _, affinity := range coRated {
+		scores[affinity.BookID] += coRatingAffinityWeight * math.Log1p(float64(affinity.Score))
+	}
+
+	return rankScores(scores, models.MaxRecommendedBooks), nil
+}
+
+func (r *recommendationService) GetSimilarBooks(ctx context.Context, bookID uuid.UUID, limit int) ([]*models.PublishedBookResponse, error) {
+	session, ok := ctx.Value(internal.SessionKey).(models.Session)
+	if !ok {
+		return nil, models.ErrUserNotFoundInContext
+	}
+
+	var similarIDs []uuid.UUID
+	if err := r.cacheService.Get(ctx, GetSimilarBooksKey(bookID), &similarIDs); err != nil {
+		if !errors.Is(err, cache.ErrCacheMiss) {
+			return nil, fmt.Errorf("get similar books from cache: %w", err)
+		}
+
+		similarIDs, err = r.computeSimilarBooks(ctx, bookID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := r.cacheService.Set(ctx, GetSimilarBooksKey(bookID), similarIDs, similarBooksTTL); err != nil {
+			return nil, fmt.Errorf("set similar books to cache: %w", err)
+		}
+	}
+
+	if len(similarIDs) == 0 {
+		return []*models.PublishedBookResponse{}, nil
+	}
+
+	books, err := r.recommendationRepository.GetPublishedBooksByID(ctx, similarIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get published books by id: %w", err)
+	}
+
+	booksByID := make(map[uuid.UUID]models.Book, len(books))
+	for _, book := range books {
+		booksByID[book.ID] = book
+	}
+
+	response := make([]*models.PublishedBookResponse, 0, limit)
+	for _, ID := range similarIDs {
+		book, exists := booksByID[ID]
+		if !exists {
+			continue
+		}
+
+		response = append(response, book.ToPublishedBookResponse(calculateAverageRating(book.Evaluations), userHasReadBook(session.UserID, book.Evaluations)))
+		if len(response) == limit {
+			break
+		}
+	}
+
+	return response, nil
+}
+
+func (r *recommendationService) computeSimilarBooks(ctx context.Context, bookID uuid.UUID) ([]uuid.UUID, error) {
+	books, err := r.recommendationRepository.GetPublishedBooksByID(ctx, []uuid.UUID{bookID})
+	if err != nil {
+		return nil, fmt.Errorf("get published book by id %q: %w", bookID, err)
+	}
+
+	if len(books) == 0 {
+		return nil, models.ErrBookNotFound
+	}
+
+	book := books[0]
+
+	categoryIDs := make(map[uuid.UUID]struct{}, len(book.Categories))
+	for _, category := range book.Categories {
+		categoryIDs[category.ID] = struct{}{}
+	}
+
+	authorIDs := make(map[uuid.UUID]struct{}, len(book.Authors))
+	for _, author := range book.Authors {
+		authorIDs[author.ID] = struct{}{}
+	}
+
+	candidates, err := r.recommendationRepository.GetBooksSharingAssociations(ctx, bookID, setKeys(categoryIDs), setKeys(authorIDs), recommendationCandidateLimit)
+	if err != nil {
+		return nil, fmt.Errorf("get books sharing associations: %w", err)
+	}
+
+	scores := make(map[uuid.UUID]float64)
+	for _, candidate := range candidates {
+		var score float64
+		for _, category := range candidate.Categories {
+			if _, shared := categoryIDs[category.ID]; shared {
+				score += categoryAffinityWeight
+			}
+		}
+
+		for _, author := range candidate.Authors {
+			if _, shared := authorIDs[author.ID]; shared {
+				score += authorAffinityWeight
+			}
+		}
+
+		scores[candidate.ID] = score
+	}
+
+	coRated, err := r.recommendationRepository.GetCoRatedBooksForBook(ctx, bookID, recommendationLikedRate, recommendationCandidateLimit)
+	if err != nil {
+		return nil, fmt.Errorf("get co-rated books: %w", err)
+	}
+
+	for _, affinity := range coRated {
+		scores[affinity.BookID] += coRatingAffinityWeight * math.Log1p(float64(affinity.Score))
+	}
+
+	return rankScores(scores, models.MaxSimilarBooks), nil
+}
+
+func rankScores(scores map[uuid.UUID]float64, limit int) []uuid.UUID {
+	var IDs []uuid.UUID
+	for ID, score := range scores {
+		if score > 0 {
+			IDs = append(IDs, ID)
+		}
+	}
+
+	sort.Slice(IDs, func(i, j int) bool {
+		if scores[IDs[i]] != scores[IDs[j]] {
+			return scores[IDs[i]] > scores[IDs[j]]
+		}
+
+		return IDs[i].String() < IDs[j].String()
+	})
+
+	if len(IDs) > limit {
+		IDs = IDs[:limit]
+	}
+
+	return IDs
+}
+
+func setKeys(set map[uuid.UUID]struct{}) []uuid.UUID {
+	keys := make([]uuid.UUID, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+func positiveKeys(weights map[uuid.UUID]float64) []uuid.UUID {
+	var keys []uuid.UUID
+	for key, weight := range weights {
+		if weight > 0 {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+func getRecommendationsKey(userID uuid.UUID) string {
+	return fmt.Sprintf("recommendations:%s", userID.String())
+}
+
+// getRecommendationsComputingKey marks a computation as already scheduled, so
+// repeated cache misses don't flood the queue while the worker catches up.
+func getRecommendationsComputingKey(userID uuid.UUID) string {
+	return fmt.Sprintf("recommendations:computing:%s", userID.String())
+}
+
+func GetSimilarBooksKey(bookID uuid.UUID) string {
+	return fmt.Sprintf("books:similar:%s", bookID.String())
+}
+
+func InvalidateSimilarBooks(ctx context.Context, cacheService cache.CacheService, bookIDs []uuid.UUID) error {
+	for _, bookID := range bookIDs {
+		if err := cacheService.Delete(ctx, GetSimilarBooksKey(bookID)); err != nil {
+			return fmt.Errorf("delete similar books cache for book %q: %w", bookID, err)
+		}
+	}
+
+	return nil
+}
@@ -32,6 +32,7 @@ type userService struct {
 	di             *internal.Di
 	authService    AuthService
 	cacheService   cache.CacheService
+	imageService   ImageService
 	queueService   QueueService
 	sessionService SessionService
 	userRepository repositories.UserRepository
@@ -48,6 +49,11 @@ func NewUserService(di *internal.Di) (UserService, error) {
 		return nil, err
 	}
 
+	imageService, err := internal.Invoke[ImageService](di)
+	if err != nil {
+		return nil, err
+	}
+
 	queueService, err := internal.Invoke[QueueService](di)
 	if err != nil {
 		return nil, err
@@ -67,6 +73,7 @@ func NewUserService(di *internal.Di) (UserService, error) {
 		di:             di,
 		authService:    authService,
 		cacheService:   cacheService,
+		imageService:   imageService,
 		queueService:   queueService,
 		sessionService: sessionService,
 		userRepository: userRepository,
@@ -98,7 +105,7 @@ func (u *userService) GetUser(ctx context.Context) (*models.UserResponse, error)
 	}
 
 	var userResponse models.UserResponse
-	err := u.cacheService.Get(ctx, getUserKey(session.UserID), &userResponse)
+	err := u.cacheService.Get(ctx, GetUserKey(session.UserID), &userResponse)
 	if err == nil {
 		return &userResponse, nil
 	}
@@ -117,7 +124,7 @@ func (u *userService) GetUser(ctx context.Context) (*models.UserResponse, error)
 	}
 
 	ttl := time.Duration(config.Env.Cache.CacheExp) * time.Minute
-	if err := u.cacheService.Set(ctx, getUserKey(session.UserID), user.ToUserResponse(), ttl); err != nil {
+	if err := u.cacheService.Set(ctx, GetUserKey(session.UserID), user.ToUserResponse(), ttl); err != nil {
 		return nil, fmt.Errorf("set user to cache: %w", err)
 	}
 
@@ -226,6 +233,10 @@ func (u *userService) DeleteAdminByID(ctx context.Context, adminID uuid.UUID) er
 		return fmt.Errorf("delete user by ID %q: %w", adminID, err)
 	}
 
+	if err := u.imageService.ScheduleImageDeletion(user.AvatarImageIDs()); err != nil {
+		return fmt.Errorf("schedule avatar deletion for user %q: %w", adminID, err)
+	}
+
 	if err := u.sessionService.DeleteAllSessions(ctx, adminID); err != nil {
 		if !errors.Is(err, models.ErrSessionNotFound) {
 			return err
@@ -290,7 +301,7 @@ func (u *userService) UpdateUser(ctx context.Context, payload models.UpdateUserP
 		return models.ErrUserNotFoundInContext
 	}
 
-	user, err := u.userRepository.GetUserByID(ctx, session.SessionID, []models.Role{models.Member, models.Admin})
+	user, err := u.userRepository.GetUserByID(ctx, session.UserID, []models.Role{models.Member, models.Admin})
 	if err != nil {
 		return fmt.Errorf("get user by id %q: %w", session.UserID, err)
 	}
@@ -319,7 +330,7 @@ func (u *userService) UpdateUser(ctx context.Context, payload models.UpdateUserP
 			return fmt.Errorf("marshal upload image task: %w", err)
 		}
 
-		if err := u.queueService.Publish(string(UploadAuthorImage), message); err != nil {
+		if err := u.queueService.Publish(UploadUserImage, message); err != nil {
 			return err
 		}
 	}
@@ -348,6 +359,6 @@ func (u *userService) validateEmailChange(ctx context.Context, user *models.User
 	return nil
 }
 
-func getUserKey(userID uuid.UUID) string {
+func GetUserKey(userID uuid.UUID) string {
 	return fmt.Sprintf("user:%s", userID.String())
 }
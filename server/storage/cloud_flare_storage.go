@@ -0,0 +1,68 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/G-Villarinho/book-wise-api/clients"
+	"github.com/G-Villarinho/book-wise-api/config"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/google/uuid"
+)
+
+const cloudFlareListPageSize = 100
+
+type cloudFlareImageStorage struct {
+	di                    *internal.Di
+	cloudFlareImageClient clients.CloudFlareImageClient
+}
+
+func NewCloudFlareImageStorage(di *internal.Di) (ImageStorage, error) {
+	cloudFlareImageClient, err := internal.Invoke[clients.CloudFlareImageClient](di)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cloudFlareImageStorage{
+		di:                    di,
+		cloudFlareImageClient: cloudFlareImageClient,
+	}, nil
+}
+
+func (c *cloudFlareImageStorage) Upload(ctx context.Context, imageBytes []byte, filename string) (*models.UploadImageResponse, error) {
+	response, err := c.cloudFlareImageClient.UploadImage(imageBytes, filename)
+	if err != nil {
+		return nil, fmt.Errorf("upload image to cloudflare: %w", err)
+	}
+
+	return response, nil
+}
+
+func (c *cloudFlareImageStorage) Delete(ctx context.Context, imageID uuid.UUID) error {
+	if err := c.cloudFlareImageClient.DeleteImage(imageID); err != nil {
+		return fmt.Errorf("delete image %q from cloudflare: %w", imageID, err)
+	}
+
+	return nil
+}
+
+func (c *cloudFlareImageStorage) URL(imageID uuid.UUID) string {
+	return fmt.Sprintf("%s/%s/public", config.Env.CloudFlare.CloudFlareImageDeliveryUrl, imageID.String())
+}
+
+func (c *cloudFlareImageStorage) List(ctx context.Context) ([]models.StoredImage, error) {
+	var images []models.StoredImage
+	for page := 1; ; page++ {
+		pageImages, err := c.cloudFlareImageClient.ListImages(page, cloudFlareListPageSize)
+		if err != nil {
+			return nil, fmt.Errorf("list cloudflare images page %d: %w", page, err)
+		}
+
+		if len(pageImages) == 0 {
+			return images, nil
+		}
+
+		images = append(images, pageImages...)
+	}
+}
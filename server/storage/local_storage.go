@@ -0,0 +1,98 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/G-Villarinho/book-wise-api/config"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/google/uuid"
+)
+
+type localImageStorage struct {
+	di   *internal.Di
+	path string
+}
+
+func NewLocalImageStorage(di *internal.Di) (ImageStorage, error) {
+	path := config.Env.ImageStorage.LocalPath
+	if path == "" {
+		return nil, errors.New("local image storage path is not configured")
+	}
+
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		return nil, fmt.Errorf("create local image storage directory: %w", err)
+	}
+
+	return &localImageStorage{
+		di:   di,
+		path: path,
+	}, nil
+}
+
+func (l *localImageStorage) Upload(ctx context.Context, imageBytes []byte, filename string) (*models.UploadImageResponse, error) {
+	ID, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("generate image id: %w", err)
+	}
+
+	if err := os.WriteFile(l.filePath(ID), imageBytes, 0o644); err != nil {
+		return nil, fmt.Errorf("write image %s to disk: %w", filename, err)
+	}
+
+	return &models.UploadImageResponse{
+		ID:  ID,
+		URL: l.URL(ID),
+	}, nil
+}
+
+func (l *localImageStorage) Delete(ctx context.Context, imageID uuid.UUID) error {
+	if err := os.Remove(l.filePath(imageID)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("remove image %q from disk: %w", imageID, err)
+	}
+
+	return nil
+}
+
+func (l *localImageStorage) URL(imageID uuid.UUID) string {
+	return fmt.Sprintf("%s/images/%s", config.Env.APIBaseURL, imageID.String())
+}
+
+func (l *localImageStorage) filePath(imageID uuid.UUID) string {
+	return filepath.Join(l.path, imageID.String())
+}
+
+func (l *localImageStorage) List(ctx context.Context) ([]models.StoredImage, error) {
+	entries, err := os.ReadDir(l.path)
+	if err != nil {
+		return nil, fmt.Errorf("read local image storage directory: %w", err)
+	}
+
+	var images []models.StoredImage
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		ID, err := uuid.Parse(entry.Name())
+		if err != nil {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil, fmt.Errorf("stat image %q: %w", ID, err)
+		}
+
+		images = append(images, models.StoredImage{
+			ID:         ID,
+			UploadedAt: info.ModTime(),
+		})
+	}
+
+	return images, nil
+}
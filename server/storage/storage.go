@@ -0,0 +1,34 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/G-Villarinho/book-wise-api/config"
+	"github.com/G-Villarinho/book-wise-api/internal"
+	"github.com/G-Villarinho/book-wise-api/models"
+	"github.com/google/uuid"
+)
+
+const (
+	CloudFlareDriver = "cloudflare"
+	LocalDriver      = "local"
+)
+
+type ImageStorage interface {
+	Upload(ctx context.Context, imageBytes []byte, filename string) (*models.UploadImageResponse, error)
+	Delete(ctx context.Context, imageID uuid.UUID) error
+	URL(imageID uuid.UUID) string
+	List(ctx context.Context) ([]models.StoredImage, error)
+}
+
+func NewImageStorage(di *internal.Di) (ImageStorage, error) {
+	switch config.Env.ImageStorage.Driver {
+	case LocalDriver:
+		return NewLocalImageStorage(di)
+	case CloudFlareDriver, "":
+		return NewCloudFlareImageStorage(di)
+	default:
+		return nil, fmt.Errorf("unknown image storage driver %q", config.Env.ImageStorage.Driver)
+	}
+}
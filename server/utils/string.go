@@ -5,6 +5,10 @@ import (
 	"reflect"
 	"strings"
 	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
 )
 
 func TrimStrings(payload any) error {
@@ -48,6 +52,80 @@ func NormalizeString(str string) string {
 	return string(result)
 }
 
+var leetspeakDigits = map[rune]rune{
+	'0': 'o',
+	'1': 'i',
+	'3': 'e',
+	'4': 'a',
+	'5': 's',
+	'7': 't',
+	'8': 'b',
+}
+
+var leetspeakSymbols = map[rune]rune{
+	'@': 'a',
+	'$': 's',
+	'!': 'i',
+}
+
+func RemoveAccents(str string) string {
+	unaccented, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), str)
+	if err != nil {
+		return str
+	}
+
+	return unaccented
+}
+
+// NormalizeLeetspeak maps leetspeak characters back to letters. Symbols are
+// only mapped when a letter or digit follows them, so punctuation at the end
+// of a word ("idiot!") is left to be stripped instead of becoming a letter.
+func NormalizeLeetspeak(str string) string {
+	chars := []rune(str)
+
+	var builder strings.Builder
+	for i, r := range chars {
+		if letter, ok := leetspeakDigits[r]; ok {
+			builder.WriteRune(letter)
+			continue
+		}
+
+		if letter, ok := leetspeakSymbols[r]; ok && i+1 < len(chars) && isAlphanumeric(chars[i+1]) {
+			builder.WriteRune(letter)
+			continue
+		}
+
+		builder.WriteRune(r)
+	}
+
+	return builder.String()
+}
+
+func isAlphanumeric(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func GenerateSlug(str string) string {
+	unaccented := RemoveAccents(str)
+
+	var builder strings.Builder
+	lastDash := true
+	for _, r := range strings.ToLower(unaccented) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			builder.WriteRune(r)
+			lastDash = false
+			continue
+		}
+
+		if !lastDash {
+			builder.WriteRune('-')
+			lastDash = true
+		}
+	}
+
+	return strings.TrimSuffix(builder.String(), "-")
+}
+
 func GetQueryStringPointer(value string) *string {
 	if value == "" {
 		return nil